@@ -1,5 +1,7 @@
 package compress
 
+import "sort"
+
 // ICompress is an interface that requires a type to return a pointer to Compress[T].
 type ICompress[T any] interface {
 	Compress() *Compress[T]
@@ -18,6 +20,15 @@ func New[T any](data []T) *Compress[T] {
 	return &Compress[T]{data}
 }
 
+// Clone returns a new Compress backed by a fresh copy of the data, so in-place operations such as
+// Map, Filter or Reverse on one do not affect the other. Elements are copied shallowly:
+// pointers, slices and maps inside them are shared.
+func (c *Compress[T]) Clone() *Compress[T] {
+	data := make([]T, len(c.data))
+	copy(data, c.data)
+	return &Compress[T]{data: data}
+}
+
 // Filter keeps only the elements for which the provided function returns true.
 // If the receiver or its data is nil, it returns nil.
 func (c *Compress[T]) Filter(predicate func(T) bool) *Compress[T] {
@@ -34,6 +45,50 @@ func (c *Compress[T]) Filter(predicate func(T) bool) *Compress[T] {
 	return c
 }
 
+// FilterCount behaves like Filter and also reports how many elements were removed.
+func (c *Compress[T]) FilterCount(predicate func(T) bool) (kept *Compress[T], removed int) {
+	before := len(c.data)
+	kept = c.Filter(predicate)
+	return kept, before - len(kept.data)
+}
+
+// FilterErr keeps only the elements for which the predicate returns true.
+// If the predicate fails, filtering stops and the elements kept so far are returned with the error.
+func (c *Compress[T]) FilterErr(predicate func(T) (bool, error)) (*Compress[T], error) {
+	if len(c.data) == 0 {
+		return c, nil
+	}
+	filteredData := make([]T, 0, len(c.data))
+	for _, elem := range c.data {
+		keep, err := predicate(elem)
+		if err != nil {
+			c.data = filteredData
+			return c, err
+		}
+		if keep {
+			filteredData = append(filteredData, elem)
+		}
+	}
+	c.data = filteredData
+	return c, nil
+}
+
+// Partition splits the elements in a single pass into two new Compress instances:
+// the first holds the elements that satisfy the predicate and the second the rest.
+// Both keep the original relative order.
+func (c *Compress[T]) Partition(predicate func(T) bool) (*Compress[T], *Compress[T]) {
+	matched := make([]T, 0)
+	rest := make([]T, 0)
+	for _, elem := range c.data {
+		if predicate(elem) {
+			matched = append(matched, elem)
+		} else {
+			rest = append(rest, elem)
+		}
+	}
+	return &Compress[T]{data: matched}, &Compress[T]{data: rest}
+}
+
 // Map applies the provided function to each element in the slice, modifying it in place.
 // If the receiver or its data is nil, it returns nil.
 func (c *Compress[T]) Map(predicate func(T) T) *Compress[T] {
@@ -46,19 +101,42 @@ func (c *Compress[T]) Map(predicate func(T) T) *Compress[T] {
 	return c
 }
 
-func (c *Compress[T]) FlatMap(transfrom func(T) []T) *Compress[T] {
+// MapWhile maps elements in order until fn returns false,
+// discarding the element that stopped it and everything after.
+func (c *Compress[T]) MapWhile(fn func(T) (T, bool)) *Compress[T] {
 	if len(c.data) == 0 {
 		return c
 	}
-	for _, item := range c.data {
-		for _, transfomed := range transfrom(item) {
-			c.data = append(c.data, transfomed)
+	for i, elem := range c.data {
+		mapped, ok := fn(elem)
+		if !ok {
+			c.data = c.data[:i]
+			break
 		}
+		c.data[i] = mapped
 	}
+	return c
+}
 
+// FlatMap replaces every element with the elements returned by transform, in order.
+func (c *Compress[T]) FlatMap(transform func(T) []T) *Compress[T] {
+	if len(c.data) == 0 {
+		return c
+	}
+	result := make([]T, 0, len(c.data))
+	for _, item := range c.data {
+		result = append(result, transform(item)...)
+	}
+	c.data = result
 	return c
 }
 
+// FlatMapReplace replaces every element with the output of transform, in order,
+// so the result contains only the expanded elements and none of the originals. It is equivalent to FlatMap.
+func (c *Compress[T]) FlatMapReplace(transform func(T) []T) *Compress[T] {
+	return c.FlatMap(transform)
+}
+
 // At returns the element at the specified index.
 // If the index is out of bounds, it clamps to [0, len-1].
 // If the slice is nil or empty, returns the zero value of T.
@@ -153,6 +231,34 @@ func (c *Compress[T]) Every(predicate func(T) bool) bool {
 	return true
 }
 
+// Some checks if at least one element satisfies the predicate, stopping at the first match.
+// It returns false if the slice is nil or empty.
+func (c *Compress[T]) Some(predicate func(T) bool) bool {
+	for _, elem := range c.data {
+		if predicate(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of elements that satisfy the predicate.
+// It returns 0 if the slice is nil or empty.
+func (c *Compress[T]) Count(predicate func(T) bool) int {
+	count := 0
+	for _, elem := range c.data {
+		if predicate(elem) {
+			count++
+		}
+	}
+	return count
+}
+
+// Len returns the number of elements in the slice.
+func (c *Compress[T]) Len() int {
+	return len(c.data)
+}
+
 // Entries returns a slice of [index, value] pairs from the internal data slice.
 // Each pair is represented as [2]any, where the first is the index (int) and second is the value (T).
 func (c *Compress[T]) Entries() [][2]any {
@@ -182,6 +288,40 @@ func (c *Compress[T]) Find(predicate func(T) bool) T {
 	return value
 }
 
+// FindWithIndex returns the index and value of the first element that satisfies the predicate.
+// If no element matches or the slice is nil/empty, it returns -1, the zero value of T and false.
+func (c *Compress[T]) FindWithIndex(predicate func(T) bool) (index int, value T, ok bool) {
+	for i, e := range c.data {
+		if predicate(e) {
+			return i, e, true
+		}
+	}
+	return -1, value, false
+}
+
+// Index builds a lookup map from keyFn(element) to element in a single pass.
+// Keep the returned map to replace repeated Find calls with constant-time lookups.
+// When two elements share a key, the last one wins.
+func (c *Compress[T]) Index(keyFn func(T) string) map[string]T {
+	return ToMap(c, keyFn)
+}
+
+// CheckUniqueKeys reports the keys produced by keyFn more than once, with how many elements share each,
+// so collisions can be detected before building a map. ok is true when every key is unique.
+func (c *Compress[T]) CheckUniqueKeys(keyFn func(T) string) (duplicates map[string]int, ok bool) {
+	counts := make(map[string]int, len(c.data))
+	for _, e := range c.data {
+		counts[keyFn(e)]++
+	}
+	duplicates = make(map[string]int)
+	for key, count := range counts {
+		if count > 1 {
+			duplicates[key] = count
+		}
+	}
+	return duplicates, len(duplicates) == 0
+}
+
 func (c *Compress[T]) Reduce(inital T, reducer func(T, T) T) T {
 	result := inital
 	for _, item := range c.data {
@@ -190,22 +330,141 @@ func (c *Compress[T]) Reduce(inital T, reducer func(T, T) T) T {
 	return result
 }
 
-func (c *Compress[T]) Limit(n int) *Compress[T] {
+// Scan returns a new Compress with the accumulator value after folding in each element.
+// The initial value itself is not emitted, so the result has the same length as the receiver.
+func (c *Compress[T]) Scan(initial T, f func(T, T) T) *Compress[T] {
+	return ScanFold(c, initial, f)
+}
+
+// Reduce1 reduces the slice using its first element as the seed.
+// It returns false if the slice is nil or empty.
+func (c *Compress[T]) Reduce1(reducer func(acc, cur T) T) (T, bool) {
+	var value T
 	if len(c.data) == 0 {
-		return c
+		return value, false
 	}
-	result := make([]T, len(c.data))
-	count := 0
-	for _, item := range c.data {
-		if count >= n {
+	value = c.data[0]
+	for _, item := range c.data[1:] {
+		value = reducer(value, item)
+	}
+	return value, true
+}
+
+// TakeWhile keeps, in place, the leading run of elements that satisfy the predicate,
+// dropping everything from the first failure onwards, and returns the receiver.
+func (c *Compress[T]) TakeWhile(predicate func(T) bool) *Compress[T] {
+	for i, elem := range c.data {
+		if !predicate(elem) {
+			c.data = c.data[:i]
 			break
 		}
-		result = append(result, item)
-		count++
 	}
+	return c
+}
+
+// DropWhile discards, in place, the leading run of elements that satisfy the predicate,
+// keeping everything from the first failure onwards, and returns the receiver.
+func (c *Compress[T]) DropWhile(predicate func(T) bool) *Compress[T] {
+	for i, elem := range c.data {
+		if !predicate(elem) {
+			c.data = c.data[i:]
+			return c
+		}
+	}
+	c.data = c.data[:0]
+	return c
+}
+
+// Limit returns a new Compress with at most the first n elements.
+// If n is zero or negative, the result is empty.
+func (c *Compress[T]) Limit(n int) *Compress[T] {
+	n = min(max(n, 0), len(c.data))
+	result := make([]T, n)
+	copy(result, c.data[:n])
 	return &Compress[T]{data: result}
 }
 
+// SortedInsert inserts value into an already sorted slice at the position found by binary search,
+// keeping it sorted according to less. Equal elements keep their insertion order.
+// The result is built in a new slice, so the caller's backing array is never written to.
+func (c *Compress[T]) SortedInsert(value T, less func(a, b T) bool) *Compress[T] {
+	index := sort.Search(len(c.data), func(i int) bool {
+		return less(value, c.data[i])
+	})
+	result := make([]T, len(c.data)+1)
+	copy(result, c.data[:index])
+	result[index] = value
+	copy(result[index+1:], c.data[index:])
+	c.data = result
+	return c
+}
+
+// WindowMap maps each sliding window of size consecutive elements to a single value,
+// returning a new Compress with len-size+1 elements.
+// If size is not positive or larger than the slice, the result is empty.
+func (c *Compress[T]) WindowMap(size int, fn func(window []T) T) *Compress[T] {
+	if size <= 0 || size > len(c.data) {
+		return New[T](nil)
+	}
+	result := make([]T, 0, len(c.data)-size+1)
+	for i := 0; i+size <= len(c.data); i++ {
+		result = append(result, fn(c.data[i:i+size]))
+	}
+	return &Compress[T]{data: result}
+}
+
+// Sort sorts the slice in place according to less and returns the receiver.
+// The sort is stable: equal elements keep their original order.
+func (c *Compress[T]) Sort(less func(a, b T) bool) *Compress[T] {
+	if len(c.data) == 0 {
+		return c
+	}
+	sort.SliceStable(c.data, func(i, j int) bool {
+		return less(c.data[i], c.data[j])
+	})
+	return c
+}
+
+// Chunk splits the slice into consecutive chunks of length size, the last one holding the remainder.
+// Each chunk is a copy, so mutating one never affects another or the receiver.
+// It returns nil if size is zero or negative.
+func (c *Compress[T]) Chunk(size int) [][]T {
+	if size <= 0 {
+		return nil
+	}
+	chunks := make([][]T, 0, (len(c.data)+size-1)/size)
+	for start := 0; start < len(c.data); start += size {
+		end := min(start+size, len(c.data))
+		chunk := make([]T, end-start)
+		copy(chunk, c.data[start:end])
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
+
+// Reverse reverses the slice in place and returns the receiver.
+func (c *Compress[T]) Reverse() *Compress[T] {
+	for i, j := 0, len(c.data)-1; i < j; i, j = i+1, j-1 {
+		c.data[i], c.data[j] = c.data[j], c.data[i]
+	}
+	return c
+}
+
+// ForEach calls action for every element in order. It is a terminal operation and returns nothing.
+func (c *Compress[T]) ForEach(action func(T)) {
+	for _, elem := range c.data {
+		action(elem)
+	}
+}
+
+// ForEachIndexed calls action with the index and value of every element in order.
+// It is a terminal operation and returns nothing.
+func (c *Compress[T]) ForEachIndexed(action func(int, T)) {
+	for i, elem := range c.data {
+		action(i, elem)
+	}
+}
+
 // Returns the slice modified
 func (c *Compress[T]) Collect() []T {
 	return c.data
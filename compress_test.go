@@ -1,6 +1,7 @@
 package compress
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -26,3 +27,429 @@ func TestFilter(t *testing.T) {
 	})
 
 }
+
+func TestFilterCount(t *testing.T) {
+	t.Run("should report the removed elements", func(t *testing.T) {
+		kept, removed := New([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}).FilterCount(func(elem int) bool {
+			return elem%2 != 0
+		})
+		assert.Equal(t, []int{1, 3, 5, 7, 9}, kept.Collect())
+		assert.Equal(t, 5, removed)
+	})
+	t.Run("should remove nothing from an empty slice", func(t *testing.T) {
+		kept, removed := New([]int{}).FilterCount(func(int) bool { return false })
+		assert.Empty(t, kept.Collect())
+		assert.Equal(t, 0, removed)
+	})
+}
+
+func TestSortedInsert(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	t.Run("should insert at the front", func(t *testing.T) {
+		result := New([]int{2, 4, 6}).SortedInsert(1, less).Collect()
+		assert.Equal(t, []int{1, 2, 4, 6}, result)
+	})
+	t.Run("should insert in the middle", func(t *testing.T) {
+		result := New([]int{2, 4, 6}).SortedInsert(5, less).Collect()
+		assert.Equal(t, []int{2, 4, 5, 6}, result)
+	})
+	t.Run("should insert at the end", func(t *testing.T) {
+		result := New([]int{2, 4, 6}).SortedInsert(7, less).Collect()
+		assert.Equal(t, []int{2, 4, 6, 7}, result)
+	})
+	t.Run("should insert into an empty slice", func(t *testing.T) {
+		result := New([]int{}).SortedInsert(3, less).Collect()
+		assert.Equal(t, []int{3}, result)
+	})
+	t.Run("should not write into the caller's backing array", func(t *testing.T) {
+		base := []int{1, 3, 5, 0}
+		result := New(base[:3]).SortedInsert(2, less).Collect()
+		assert.Equal(t, []int{1, 2, 3, 5}, result)
+		assert.Equal(t, []int{1, 3, 5, 0}, base)
+	})
+}
+
+func TestReduce1(t *testing.T) {
+	t.Run("should fold from the first element", func(t *testing.T) {
+		result, ok := New([]int{10, 2, 3}).Reduce1(func(acc, cur int) int {
+			return acc - cur
+		})
+		assert.True(t, ok)
+		assert.Equal(t, 5, result)
+	})
+	t.Run("should return false for an empty slice", func(t *testing.T) {
+		result, ok := New([]int{}).Reduce1(func(acc, cur int) int {
+			return acc + cur
+		})
+		assert.False(t, ok)
+		assert.Equal(t, 0, result)
+	})
+}
+
+func TestMapWhile(t *testing.T) {
+	t.Run("should stop mapping at the third element", func(t *testing.T) {
+		result := New([]int{1, 2, 3, 4, 5}).MapWhile(func(elem int) (int, bool) {
+			return elem * 10, elem < 3
+		}).Collect()
+		assert.Equal(t, []int{10, 20}, result)
+	})
+	t.Run("should map everything when fn never stops", func(t *testing.T) {
+		result := New([]int{1, 2, 3}).MapWhile(func(elem int) (int, bool) {
+			return elem + 1, true
+		}).Collect()
+		assert.Equal(t, []int{2, 3, 4}, result)
+	})
+}
+
+func TestWindowMap(t *testing.T) {
+	windowMax := func(window []int) int {
+		m := window[0]
+		for _, v := range window[1:] {
+			if v > m {
+				m = v
+			}
+		}
+		return m
+	}
+	t.Run("should compute the windowed max", func(t *testing.T) {
+		result := New([]int{3, 1, 4, 1, 5}).WindowMap(2, windowMax).Collect()
+		assert.Equal(t, []int{3, 4, 4, 5}, result)
+	})
+	t.Run("should return empty when the window is too large", func(t *testing.T) {
+		result := New([]int{3, 1}).WindowMap(3, windowMax).Collect()
+		assert.Empty(t, result)
+	})
+}
+
+func TestFlatMapReplace(t *testing.T) {
+	t.Run("should replace each element with its expansion", func(t *testing.T) {
+		result := New([]int{1, 2}).FlatMapReplace(func(x int) []int {
+			return []int{x, x}
+		}).Collect()
+		assert.Equal(t, []int{1, 1, 2, 2}, result)
+	})
+	t.Run("should drop elements expanding to nothing", func(t *testing.T) {
+		result := New([]int{1, 2, 3}).FlatMapReplace(func(x int) []int {
+			if x == 2 {
+				return nil
+			}
+			return []int{x}
+		}).Collect()
+		assert.Equal(t, []int{1, 3}, result)
+	})
+}
+
+func TestFilterErr(t *testing.T) {
+	errBad := errors.New("bad element")
+	predicate := func(elem int) (bool, error) {
+		if elem == 5 {
+			return false, errBad
+		}
+		return elem%2 == 0, nil
+	}
+	t.Run("should return the partial result and the error", func(t *testing.T) {
+		result, err := New([]int{1, 2, 3, 4, 5, 6}).FilterErr(predicate)
+		assert.ErrorIs(t, err, errBad)
+		assert.Equal(t, []int{2, 4}, result.Collect())
+	})
+	t.Run("should filter everything when no error occurs", func(t *testing.T) {
+		result, err := New([]int{1, 2, 3, 4}).FilterErr(predicate)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 4}, result.Collect())
+	})
+}
+
+func TestFindWithIndex(t *testing.T) {
+	isEven := func(elem int) bool { return elem%2 == 0 }
+	t.Run("should return the first match", func(t *testing.T) {
+		index, value, ok := New([]int{1, 3, 4, 6}).FindWithIndex(isEven)
+		assert.True(t, ok)
+		assert.Equal(t, 2, index)
+		assert.Equal(t, 4, value)
+	})
+	t.Run("should report no match", func(t *testing.T) {
+		index, value, ok := New([]int{1, 3, 5}).FindWithIndex(isEven)
+		assert.False(t, ok)
+		assert.Equal(t, -1, index)
+		assert.Equal(t, 0, value)
+	})
+	t.Run("should report no match for an empty slice", func(t *testing.T) {
+		index, _, ok := New([]int{}).FindWithIndex(isEven)
+		assert.False(t, ok)
+		assert.Equal(t, -1, index)
+	})
+}
+
+func TestIndex(t *testing.T) {
+	type user struct {
+		Email string
+		Name  string
+	}
+	users := []user{
+		{"ana@mail.com", "Ana"},
+		{"bob@mail.com", "Bob"},
+		{"ana@mail.com", "Ana Maria"},
+	}
+	index := New(users).Index(func(u user) string { return u.Email })
+	t.Run("should look up elements by key", func(t *testing.T) {
+		assert.Equal(t, "Bob", index["bob@mail.com"].Name)
+		_, ok := index["missing@mail.com"]
+		assert.False(t, ok)
+	})
+	t.Run("should keep the last element on collisions", func(t *testing.T) {
+		assert.Len(t, index, 2)
+		assert.Equal(t, "Ana Maria", index["ana@mail.com"].Name)
+	})
+}
+
+func TestCheckUniqueKeys(t *testing.T) {
+	byPrefix := func(s string) string { return s[:1] }
+	t.Run("should report colliding keys", func(t *testing.T) {
+		duplicates, ok := New([]string{"ant", "bee", "ape", "bat", "cow", "asp"}).CheckUniqueKeys(byPrefix)
+		assert.False(t, ok)
+		assert.Equal(t, map[string]int{"a": 3, "b": 2}, duplicates)
+	})
+	t.Run("should accept unique keys", func(t *testing.T) {
+		duplicates, ok := New([]string{"ant", "bee"}).CheckUniqueKeys(byPrefix)
+		assert.True(t, ok)
+		assert.Empty(t, duplicates)
+	})
+}
+
+func TestFlatMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []int
+		transform func(int) []int
+		expected  []int
+	}{
+		{
+			name:      "should expand one element into many",
+			data:      []int{1, 2, 3},
+			transform: func(x int) []int { return []int{x, x * 10, x * 100} },
+			expected:  []int{1, 10, 100, 2, 20, 200, 3, 30, 300},
+		},
+		{
+			name:      "should drop everything with an empty-producing transform",
+			data:      []int{1, 2, 3},
+			transform: func(int) []int { return nil },
+			expected:  []int{},
+		},
+		{
+			name:      "should keep an empty slice empty",
+			data:      []int{},
+			transform: func(x int) []int { return []int{x, x} },
+			expected:  []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, New(tt.data).FlatMap(tt.transform).Collect())
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{"should keep everything when n is larger", 5, []int{1, 2, 3}},
+		{"should keep everything when n equals the length", 3, []int{1, 2, 3}},
+		{"should keep the first n when n is smaller", 2, []int{1, 2}},
+		{"should return empty when n is zero", 0, []int{}},
+		{"should return empty when n is negative", -1, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, New([]int{1, 2, 3}).Limit(tt.n).Collect())
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	people := func() []person {
+		return []person{{"Ana", 30}, {"Bia", 25}, {"Caio", 30}, {"Duda", 20}}
+	}
+	t.Run("should sort ascending by age", func(t *testing.T) {
+		result := New(people()).Sort(func(a, b person) bool { return a.Age < b.Age }).Collect()
+		assert.Equal(t, []person{{"Duda", 20}, {"Bia", 25}, {"Ana", 30}, {"Caio", 30}}, result)
+	})
+	t.Run("should sort descending by age keeping ties stable", func(t *testing.T) {
+		result := New(people()).Sort(func(a, b person) bool { return a.Age > b.Age }).Collect()
+		assert.Equal(t, []person{{"Ana", 30}, {"Caio", 30}, {"Bia", 25}, {"Duda", 20}}, result)
+	})
+	t.Run("should be a no-op on empty data", func(t *testing.T) {
+		assert.Empty(t, New([]person{}).Sort(func(a, b person) bool { return a.Age < b.Age }).Collect())
+	})
+}
+
+func TestChunk(t *testing.T) {
+	t.Run("should split evenly", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}}, New([]int{1, 2, 3, 4}).Chunk(2))
+	})
+	t.Run("should keep the remainder in the last chunk", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2, 3}, {4, 5}}, New([]int{1, 2, 3, 4, 5}).Chunk(3))
+	})
+	t.Run("should return one chunk when size is larger than the length", func(t *testing.T) {
+		assert.Equal(t, [][]int{{1, 2}}, New([]int{1, 2}).Chunk(10))
+	})
+	t.Run("should return no chunks for empty input", func(t *testing.T) {
+		assert.Empty(t, New([]int{}).Chunk(2))
+	})
+	t.Run("should reject a non-positive size", func(t *testing.T) {
+		assert.Nil(t, New([]int{1, 2}).Chunk(0))
+	})
+	t.Run("should not share backing arrays", func(t *testing.T) {
+		data := []int{1, 2, 3, 4}
+		chunks := New(data).Chunk(2)
+		chunks[0] = append(chunks[0], 99)
+		chunks[1][0] = 42
+		assert.Equal(t, []int{1, 2, 3, 4}, data)
+		assert.Equal(t, []int{42, 4}, chunks[1])
+	})
+}
+
+func TestReverse(t *testing.T) {
+	t.Run("should restore the original when reversed twice", func(t *testing.T) {
+		result := New([]int{1, 2, 3, 4}).Reverse().Reverse().Collect()
+		assert.Equal(t, []int{1, 2, 3, 4}, result)
+	})
+	t.Run("should compose with Map and Filter", func(t *testing.T) {
+		result := New([]int{1, 2, 3, 4, 5}).
+			Filter(func(x int) bool { return x%2 == 1 }).
+			Reverse().
+			Map(func(x int) int { return x * 10 }).
+			Collect()
+		assert.Equal(t, []int{50, 30, 10}, result)
+	})
+	t.Run("should be a no-op on empty and single-element data", func(t *testing.T) {
+		assert.Empty(t, New([]int{}).Reverse().Collect())
+		assert.Equal(t, []int{7}, New([]int{7}).Reverse().Collect())
+	})
+}
+
+func TestCount(t *testing.T) {
+	t.Run("should count the even numbers", func(t *testing.T) {
+		assert.Equal(t, 3, New([]int{1, 2, 3, 4, 5, 6, 7}).Count(func(x int) bool { return x%2 == 0 }))
+	})
+	t.Run("should return zero for an empty slice", func(t *testing.T) {
+		assert.Equal(t, 0, New([]int{}).Count(func(int) bool { return true }))
+		assert.Equal(t, 0, New([]int{}).Len())
+	})
+	t.Run("should match the collected length", func(t *testing.T) {
+		c := New([]int{1, 2, 3, 4}).Filter(func(x int) bool { return x > 1 })
+		assert.Equal(t, len(c.Collect()), c.Len())
+	})
+}
+
+func TestSome(t *testing.T) {
+	t.Run("should stop at the first match", func(t *testing.T) {
+		calls := 0
+		found := New([]int{1, 3, 4, 5, 6}).Some(func(x int) bool {
+			calls++
+			return x%2 == 0
+		})
+		assert.True(t, found)
+		assert.Equal(t, 3, calls)
+	})
+	t.Run("should return false without a match", func(t *testing.T) {
+		assert.False(t, New([]int{1, 3}).Some(func(x int) bool { return x%2 == 0 }))
+	})
+	t.Run("should return false for an empty slice", func(t *testing.T) {
+		assert.False(t, New([]int{}).Some(func(int) bool { return true }))
+	})
+}
+
+func TestForEach(t *testing.T) {
+	t.Run("should visit elements in order", func(t *testing.T) {
+		visited := make([]string, 0)
+		New([]string{"a", "b", "c"}).ForEach(func(s string) {
+			visited = append(visited, s)
+		})
+		assert.Equal(t, []string{"a", "b", "c"}, visited)
+	})
+	t.Run("should pass the right indices", func(t *testing.T) {
+		visited := make([]string, 0)
+		New([]string{"a", "b", "c"}).ForEachIndexed(func(i int, s string) {
+			visited = append(visited, fmt.Sprintf("%d:%s", i, s))
+		})
+		assert.Equal(t, []string{"0:a", "1:b", "2:c"}, visited)
+	})
+	t.Run("should do nothing for an empty slice", func(t *testing.T) {
+		calls := 0
+		New([]int{}).ForEach(func(int) { calls++ })
+		New([]int{}).ForEachIndexed(func(int, int) { calls++ })
+		assert.Equal(t, 0, calls)
+	})
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	t.Run("should split evens and odds", func(t *testing.T) {
+		evens, odds := New([]int{5, 2, 8, 1, 4, 7}).Partition(isEven)
+		assert.Equal(t, []int{2, 8, 4}, evens.Collect())
+		assert.Equal(t, []int{5, 1, 7}, odds.Collect())
+	})
+	t.Run("should return two empty instances for empty input", func(t *testing.T) {
+		evens, odds := New([]int{}).Partition(isEven)
+		assert.NotNil(t, evens)
+		assert.NotNil(t, odds)
+		assert.Empty(t, evens.Collect())
+		assert.Empty(t, odds.Collect())
+	})
+}
+
+func TestClone(t *testing.T) {
+	t.Run("should not affect the original when the clone is mapped", func(t *testing.T) {
+		original := New([]int{1, 2, 3})
+		doubled := original.Clone().Map(func(x int) int { return x * 2 })
+		assert.Equal(t, []int{2, 4, 6}, doubled.Collect())
+		assert.Equal(t, []int{1, 2, 3}, original.Collect())
+	})
+	t.Run("should share nested references", func(t *testing.T) {
+		original := New([][]int{{1}})
+		clone := original.Clone()
+		clone.Head()[0] = 42
+		assert.Equal(t, [][]int{{42}}, original.Collect())
+	})
+}
+
+func TestTakeWhile(t *testing.T) {
+	isSmall := func(x int) bool { return x < 3 }
+	t.Run("should keep nothing when the predicate fails immediately", func(t *testing.T) {
+		assert.Empty(t, New([]int{5, 1, 2}).TakeWhile(isSmall).Collect())
+		assert.Equal(t, []int{5, 1, 2}, New([]int{5, 1, 2}).DropWhile(isSmall).Collect())
+	})
+	t.Run("should keep everything when the predicate never fails", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, New([]int{1, 2}).TakeWhile(isSmall).Collect())
+		assert.Empty(t, New([]int{1, 2}).DropWhile(isSmall).Collect())
+	})
+	t.Run("should split on the first failure", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, New([]int{1, 2, 3, 1}).TakeWhile(isSmall).Collect())
+		assert.Equal(t, []int{3, 1}, New([]int{1, 2, 3, 1}).DropWhile(isSmall).Collect())
+	})
+	t.Run("should compose TakeWhile then DropWhile", func(t *testing.T) {
+		result := New([]int{1, 2, 4, 6, 7, 8}).
+			TakeWhile(func(x int) bool { return x < 7 }).
+			DropWhile(isSmall).
+			Collect()
+		assert.Equal(t, []int{4, 6}, result)
+	})
+}
+
+func TestScan(t *testing.T) {
+	t.Run("should produce a running sum", func(t *testing.T) {
+		result := New([]int{1, 2, 3, 4}).Scan(0, func(acc, cur int) int { return acc + cur }).Collect()
+		assert.Equal(t, []int{1, 3, 6, 10}, result)
+	})
+	t.Run("should produce a running max", func(t *testing.T) {
+		result := New([]int{3, 1, 4, 1, 5}).Scan(0, func(acc, cur int) int { return max(acc, cur) }).Collect()
+		assert.Equal(t, []int{3, 3, 4, 4, 5}, result)
+	})
+}
@@ -0,0 +1,151 @@
+package compress
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// EncodeJSONArray writes the elements to w as a JSON array, encoding one element at a time
+// instead of building the whole document in memory.
+func EncodeJSONArray[T any](c *Compress[T], w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	for i, elem := range c.data {
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		encoded, err := json.Marshal(elem)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(encoded); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+// HashSeed is the initial checksum used by Hash, the 64-bit FNV offset basis.
+const HashSeed uint64 = 14695981039346656037
+
+const fnvPrime64 = 1099511628211
+
+// HashCombine folds the 8 bytes of x into the checksum h using FNV-1a.
+// Starting from HashSeed and combining every element hash in order reproduces Hash.
+func HashCombine(h, x uint64) uint64 {
+	for i := 0; i < 8; i++ {
+		h ^= x & 0xff
+		h *= fnvPrime64
+		x >>= 8
+	}
+	return h
+}
+
+// Hash folds the per-element hashes into a single order-dependent checksum using FNV-1a.
+// Equal slices hash equal, while reordering the elements almost always changes the result.
+func Hash[T any](c *Compress[T], hashElem func(T) uint64) uint64 {
+	h := HashSeed
+	for _, elem := range c.data {
+		h = HashCombine(h, hashElem(elem))
+	}
+	return h
+}
+
+// ToBitset returns a bitset with bit i set for every integer i in [0, max] present in the slice.
+// Values outside that range are ignored. If max is negative, it returns nil.
+func ToBitset(c *Compress[int], max int) []uint64 {
+	if max < 0 {
+		return nil
+	}
+	bits := make([]uint64, max/64+1)
+	for _, v := range c.data {
+		if v < 0 || v > max {
+			continue
+		}
+		bits[v/64] |= 1 << (v % 64)
+	}
+	return bits
+}
+
+// DeltaEncode returns a new Compress holding the first value followed by the difference
+// between each element and its predecessor.
+func DeltaEncode[T Number](c *Compress[T]) *Compress[T] {
+	result := make([]T, len(c.data))
+	var prev T
+	for i, v := range c.data {
+		result[i] = v - prev
+		prev = v
+	}
+	return &Compress[T]{data: result}
+}
+
+// DeltaDecode inverts DeltaEncode by accumulating the differences.
+func DeltaDecode[T Number](c *Compress[T]) *Compress[T] {
+	result := make([]T, len(c.data))
+	var acc T
+	for i, d := range c.data {
+		acc += d
+		result[i] = acc
+	}
+	return &Compress[T]{data: result}
+}
+
+// RLECompress run-length encodes the slice into value/count pairs, one per run of equal elements.
+func RLECompress[T comparable](c *Compress[T]) *Compress[Pair[T, int]] {
+	runs := make([]Pair[T, int], 0)
+	for _, v := range c.data {
+		if last := len(runs) - 1; last >= 0 && runs[last].Key == v {
+			runs[last].Value++
+			continue
+		}
+		runs = append(runs, Pair[T, int]{Key: v, Value: 1})
+	}
+	return &Compress[Pair[T, int]]{data: runs}
+}
+
+// RLEDecompress expands value/count pairs produced by RLECompress back into the original elements.
+// Pairs with a non-positive count contribute nothing.
+func RLEDecompress[T comparable](c *Compress[Pair[T, int]]) *Compress[T] {
+	result := make([]T, 0)
+	for _, run := range c.data {
+		for i := 0; i < run.Value; i++ {
+			result = append(result, run.Key)
+		}
+	}
+	return &Compress[T]{data: result}
+}
+
+// DictionaryCompress replaces every string with an integer token indexing into a dictionary
+// of distinct strings, assigned in first-seen order.
+func DictionaryCompress(c *Compress[string]) ([]int, []string) {
+	tokens := make([]int, len(c.data))
+	dict := make([]string, 0)
+	ids := make(map[string]int)
+	for i, s := range c.data {
+		id, ok := ids[s]
+		if !ok {
+			id = len(dict)
+			ids[s] = id
+			dict = append(dict, s)
+		}
+		tokens[i] = id
+	}
+	return tokens, dict
+}
+
+// DictionaryDecompress rebuilds the strings from tokens produced by DictionaryCompress.
+// Tokens outside the dictionary are skipped.
+func DictionaryDecompress(tokens []int, dict []string) *Compress[string] {
+	result := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if token < 0 || token >= len(dict) {
+			continue
+		}
+		result = append(result, dict[token])
+	}
+	return &Compress[string]{data: result}
+}
@@ -0,0 +1,96 @@
+package compress
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeJSONArray(t *testing.T) {
+	type user struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	t.Run("should round-trip through encoding/json", func(t *testing.T) {
+		users := []user{{1, "ana"}, {2, "bruno"}}
+		var buf bytes.Buffer
+		assert.NoError(t, EncodeJSONArray(New(users), &buf))
+
+		var decoded []user
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, users, decoded)
+	})
+	t.Run("should encode an empty array", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, EncodeJSONArray(New([]user{}), &buf))
+		assert.Equal(t, "[]", buf.String())
+	})
+}
+
+func TestHash(t *testing.T) {
+	identity := func(x int) uint64 { return uint64(x) }
+	t.Run("should hash identical slices equally", func(t *testing.T) {
+		assert.Equal(t, Hash(New([]int{1, 2, 3}), identity), Hash(New([]int{1, 2, 3}), identity))
+	})
+	t.Run("should depend on the element order", func(t *testing.T) {
+		assert.NotEqual(t, Hash(New([]int{1, 2, 3}), identity), Hash(New([]int{3, 2, 1}), identity))
+	})
+	t.Run("should distinguish an empty slice", func(t *testing.T) {
+		assert.NotEqual(t, Hash(New([]int{}), identity), Hash(New([]int{0}), identity))
+	})
+}
+
+func TestToBitset(t *testing.T) {
+	t.Run("should set the bits of present values", func(t *testing.T) {
+		bits := ToBitset(New([]int{0, 3, 64, 70, -1, 71, 200}), 70)
+		assert.Equal(t, []uint64{1<<0 | 1<<3, 1<<0 | 1<<6}, bits)
+	})
+	t.Run("should be idempotent for duplicates", func(t *testing.T) {
+		assert.Equal(t, ToBitset(New([]int{5}), 10), ToBitset(New([]int{5, 5, 5}), 10))
+	})
+}
+
+func TestDeltaEncode(t *testing.T) {
+	t.Run("should store the first value and differences", func(t *testing.T) {
+		assert.Equal(t, []int{100, 1, 1, 3, -5}, DeltaEncode(New([]int{100, 101, 102, 105, 100})).Collect())
+	})
+	t.Run("should round-trip", func(t *testing.T) {
+		for _, data := range [][]int64{{}, {7}, {1000, 1001, 1003, 1010}, {5, -3, 8, 8}} {
+			assert.Equal(t, data, DeltaDecode(DeltaEncode(New(data))).Collect())
+		}
+	})
+}
+
+func TestRLECompress(t *testing.T) {
+	t.Run("should encode runs including single-element ones", func(t *testing.T) {
+		runs := RLECompress(New([]string{"a", "a", "b", "c", "c", "c"})).Collect()
+		assert.Equal(t, []Pair[string, int]{{"a", 2}, {"b", 1}, {"c", 3}}, runs)
+	})
+	t.Run("should compress a uniform slice to one pair", func(t *testing.T) {
+		runs := RLECompress(New([]int{7, 7, 7, 7})).Collect()
+		assert.Equal(t, []Pair[int, int]{{7, 4}}, runs)
+	})
+	t.Run("should round-trip", func(t *testing.T) {
+		for _, data := range [][]int{{}, {1}, {1, 2, 3}, {1, 1, 2, 2, 2, 1}} {
+			assert.Equal(t, data, RLEDecompress(RLECompress(New(data))).Collect())
+		}
+	})
+}
+
+func TestDictionaryCompress(t *testing.T) {
+	t.Run("should tokenize repeated strings", func(t *testing.T) {
+		words := []string{"get", "put", "get", "get", "delete", "put"}
+		tokens, dict := DictionaryCompress(New(words))
+		assert.Equal(t, []int{0, 1, 0, 0, 2, 1}, tokens)
+		assert.Equal(t, []string{"get", "put", "delete"}, dict)
+		assert.Less(t, len(dict), len(words))
+	})
+	t.Run("should round-trip", func(t *testing.T) {
+		for _, words := range [][]string{{}, {"a"}, {"a", "b", "a", "c", "c"}} {
+			tokens, dict := DictionaryCompress(New(words))
+			assert.Equal(t, words, DictionaryDecompress(tokens, dict).Collect())
+		}
+	})
+}
@@ -0,0 +1,226 @@
+package compress
+
+import "sort"
+
+// GroupBy splits the elements into buckets by key, preserving the original order within each bucket.
+// An empty input yields an empty, non-nil map.
+func GroupBy[T any, K comparable](c *Compress[T], key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, elem := range c.data {
+		k := key(elem)
+		groups[k] = append(groups[k], elem)
+	}
+	return groups
+}
+
+// ChunkWeighted greedily packs elements, in order, into chunks whose total weight does not exceed maxWeight.
+// An element heavier than maxWeight on its own forms a single-element chunk.
+func ChunkWeighted[T any](c *Compress[T], maxWeight float64, weight func(T) float64) [][]T {
+	chunks := make([][]T, 0)
+	var current []T
+	currentWeight := 0.0
+	for _, elem := range c.data {
+		w := weight(elem)
+		if len(current) > 0 && currentWeight+w > maxWeight {
+			chunks = append(chunks, current)
+			current, currentWeight = nil, 0
+		}
+		current = append(current, elem)
+		currentWeight += w
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+	return chunks
+}
+
+// OrderedGroupBy groups elements by keyFn and also returns the keys in the order they first appeared,
+// so callers can iterate the groups deterministically.
+func OrderedGroupBy[T any, K comparable](c *Compress[T], keyFn func(T) K) ([]K, map[K][]T) {
+	keys := make([]K, 0)
+	groups := make(map[K][]T)
+	for _, elem := range c.data {
+		key := keyFn(elem)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], elem)
+	}
+	return keys, groups
+}
+
+// FoldByKey folds each group of elements sharing a key, starting every group's accumulator from seed(key).
+func FoldByKey[T any, K comparable, A any](c *Compress[T], keyFn func(T) K, seed func(K) A, reducer func(A, T) A) map[K]A {
+	result := make(map[K]A)
+	for _, elem := range c.data {
+		key := keyFn(elem)
+		acc, ok := result[key]
+		if !ok {
+			acc = seed(key)
+		}
+		result[key] = reducer(acc, elem)
+	}
+	return result
+}
+
+// DuplicatePositions returns, for every value that occurs more than once, the indices where it occurs.
+// Values that occur only once are not included.
+func DuplicatePositions[T comparable](c *Compress[T]) map[T][]int {
+	positions := make(map[T][]int)
+	for i, elem := range c.data {
+		positions[elem] = append(positions[elem], i)
+	}
+	for value, indices := range positions {
+		if len(indices) < 2 {
+			delete(positions, value)
+		}
+	}
+	return positions
+}
+
+// PartitionBalanced distributes the elements into parts groups using the greedy longest-processing-time rule:
+// heaviest elements first, each assigned to the currently lightest group.
+// Elements keep their original relative order inside each group. If parts is not positive, it returns nil.
+func PartitionBalanced[T any](c *Compress[T], parts int, weight func(T) float64) [][]T {
+	if parts <= 0 {
+		return nil
+	}
+	weights := make([]float64, len(c.data))
+	order := make([]int, len(c.data))
+	for i, elem := range c.data {
+		weights[i] = weight(elem)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return weights[order[a]] > weights[order[b]]
+	})
+	assigned := make([]int, len(c.data))
+	totals := make([]float64, parts)
+	for _, index := range order {
+		lightest := 0
+		for g := 1; g < parts; g++ {
+			if totals[g] < totals[lightest] {
+				lightest = g
+			}
+		}
+		assigned[index] = lightest
+		totals[lightest] += weights[index]
+	}
+	groups := make([][]T, parts)
+	for i, elem := range c.data {
+		groups[assigned[i]] = append(groups[assigned[i]], elem)
+	}
+	return groups
+}
+
+// CountByPrefix counts the strings sharing the same first prefixLen characters (runes).
+// Strings shorter than prefixLen are counted under their full value.
+// A non-positive prefixLen counts every string under the empty prefix.
+func CountByPrefix(c *Compress[string], prefixLen int) map[string]int {
+	prefixLen = max(prefixLen, 0)
+	counts := make(map[string]int)
+	for _, s := range c.data {
+		runes := []rune(s)
+		if len(runes) > prefixLen {
+			s = string(runes[:prefixLen])
+		}
+		counts[s]++
+	}
+	return counts
+}
+
+// TopKByKey groups elements by keyFn and keeps the first k elements of each group when ordered by less.
+// Pass a "greater than" function to keep the largest elements. Ties keep their original order.
+func TopKByKey[T any, K comparable](c *Compress[T], keyFn func(T) K, k int, less func(a, b T) bool) map[K][]T {
+	groups := GroupBy(c, keyFn)
+	for key, group := range groups {
+		sort.SliceStable(group, func(i, j int) bool {
+			return less(group[i], group[j])
+		})
+		if k < len(group) {
+			groups[key] = group[:max(k, 0)]
+		}
+	}
+	return groups
+}
+
+// BucketSort distributes the elements into numBuckets ordered buckets by bucketFn,
+// keeping the original order within each bucket. Elements whose bucket index falls
+// outside [0, numBuckets) are dropped.
+func BucketSort[T any](c *Compress[T], bucketFn func(T) int, numBuckets int) [][]T {
+	if numBuckets <= 0 {
+		return [][]T{}
+	}
+	buckets := make([][]T, numBuckets)
+	for _, elem := range c.data {
+		index := bucketFn(elem)
+		if index < 0 || index >= numBuckets {
+			continue
+		}
+		buckets[index] = append(buckets[index], elem)
+	}
+	return buckets
+}
+
+// BuildTree indexes the elements by their parent id, turning flat parent/child rows into a children map.
+// Elements for which parent reports false, or that name themselves as parent, are roots:
+// they are not listed as anyone's child.
+func BuildTree[T any, K comparable](c *Compress[T], id func(T) K, parent func(T) (K, bool)) map[K][]T {
+	children := make(map[K][]T)
+	for _, elem := range c.data {
+		parentID, ok := parent(elem)
+		if !ok || parentID == id(elem) {
+			continue
+		}
+		children[parentID] = append(children[parentID], elem)
+	}
+	return children
+}
+
+// CrossTab builds a contingency table counting the elements for every (row, column) key combination.
+// Only combinations that occur are present.
+func CrossTab[T any, R, C comparable](c *Compress[T], rowKey func(T) R, colKey func(T) C) map[R]map[C]int {
+	table := make(map[R]map[C]int)
+	for _, elem := range c.data {
+		row := rowKey(elem)
+		if table[row] == nil {
+			table[row] = make(map[C]int)
+		}
+		table[row][colKey(elem)]++
+	}
+	return table
+}
+
+// ToMap builds a map from key(element) to element. When two elements produce the same key,
+// the last one wins. An empty input yields an empty, non-nil map.
+func ToMap[T any, K comparable](c *Compress[T], key func(T) K) map[K]T {
+	return ToMapWithValue(c, key, func(elem T) T { return elem })
+}
+
+// ToMapWithValue builds a map from key(element) to val(element). When two elements produce the same key,
+// the last one wins. An empty input yields an empty, non-nil map.
+func ToMapWithValue[T any, K comparable, V any](c *Compress[T], key func(T) K, val func(T) V) map[K]V {
+	result := make(map[K]V, len(c.data))
+	for _, elem := range c.data {
+		result[key(elem)] = val(elem)
+	}
+	return result
+}
+
+// SumByKey sums valFn over the elements of each key, returning the totals as key/total pairs
+// in the order keys first appeared. Use SortByKey afterwards for a sorted result.
+func SumByKey[T any, K comparable, N Number](c *Compress[T], keyFn func(T) K, valFn func(T) N) *Compress[Pair[K, N]] {
+	totals := make([]Pair[K, N], 0)
+	positions := make(map[K]int)
+	for _, elem := range c.data {
+		key := keyFn(elem)
+		pos, ok := positions[key]
+		if !ok {
+			pos = len(totals)
+			positions[key] = pos
+			totals = append(totals, Pair[K, N]{Key: key})
+		}
+		totals[pos].Value += valFn(elem)
+	}
+	return &Compress[Pair[K, N]]{data: totals}
+}
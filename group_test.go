@@ -0,0 +1,248 @@
+package compress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunkWeighted(t *testing.T) {
+	weight := func(x int) float64 { return float64(x) }
+	t.Run("should produce uneven chunks under the limit", func(t *testing.T) {
+		chunks := ChunkWeighted(New([]int{4, 3, 2, 5, 1, 1, 1}), 7, weight)
+		assert.Equal(t, [][]int{{4, 3}, {2, 5}, {1, 1, 1}}, chunks)
+	})
+	t.Run("should isolate an over-limit element", func(t *testing.T) {
+		chunks := ChunkWeighted(New([]int{2, 10, 3}), 5, weight)
+		assert.Equal(t, [][]int{{2}, {10}, {3}}, chunks)
+	})
+	t.Run("should return no chunks for an empty slice", func(t *testing.T) {
+		assert.Empty(t, ChunkWeighted(New([]int{}), 5, weight))
+	})
+}
+
+func TestOrderedGroupBy(t *testing.T) {
+	t.Run("should return keys in first-seen order", func(t *testing.T) {
+		words := []string{"banana", "apple", "blueberry", "cherry", "avocado"}
+		keys, groups := OrderedGroupBy(New(words), func(w string) byte { return w[0] })
+		assert.Equal(t, []byte{'b', 'a', 'c'}, keys)
+		assert.Equal(t, []string{"banana", "blueberry"}, groups['b'])
+		assert.Equal(t, []string{"apple", "avocado"}, groups['a'])
+		assert.Equal(t, []string{"cherry"}, groups['c'])
+	})
+}
+
+func TestFoldByKey(t *testing.T) {
+	type item struct {
+		Category string
+		Name     string
+	}
+	t.Run("should seed each group by its key", func(t *testing.T) {
+		items := []item{{"fruit", "apple"}, {"veg", "leek"}, {"fruit", "pear"}}
+		baseline := map[string]int{"fruit": 100, "veg": 10}
+		counts := FoldByKey(New(items),
+			func(i item) string { return i.Category },
+			func(k string) int { return baseline[k] },
+			func(acc int, _ item) int { return acc + 1 },
+		)
+		assert.Equal(t, map[string]int{"fruit": 102, "veg": 11}, counts)
+	})
+}
+
+func TestDuplicatePositions(t *testing.T) {
+	t.Run("should report only duplicated values", func(t *testing.T) {
+		result := DuplicatePositions(New([]string{"a", "b", "a", "c", "b", "a"}))
+		assert.Equal(t, map[string][]int{"a": {0, 2, 5}, "b": {1, 4}}, result)
+	})
+	t.Run("should return an empty map for unique values", func(t *testing.T) {
+		assert.Empty(t, DuplicatePositions(New([]int{1, 2, 3})))
+	})
+}
+
+func TestPartitionBalanced(t *testing.T) {
+	weight := func(x int) float64 { return float64(x) }
+	t.Run("should balance skewed weights", func(t *testing.T) {
+		groups := PartitionBalanced(New([]int{1, 1, 1, 1, 10, 1, 1, 7, 1, 2}), 3, weight)
+		assert.Len(t, groups, 3)
+		totals := make([]float64, len(groups))
+		count := 0
+		for i, group := range groups {
+			for _, x := range group {
+				totals[i] += weight(x)
+			}
+			count += len(group)
+		}
+		assert.Equal(t, 10, count)
+		assert.ElementsMatch(t, []float64{10, 8, 8}, totals)
+	})
+	t.Run("should return nil for non-positive parts", func(t *testing.T) {
+		assert.Nil(t, PartitionBalanced(New([]int{1, 2}), 0, weight))
+	})
+}
+
+func TestCountByPrefix(t *testing.T) {
+	t.Run("should count strings by their prefix", func(t *testing.T) {
+		words := []string{"apple", "apricot", "banana", "band", "ap", "b"}
+		result := CountByPrefix(New(words), 3)
+		assert.Equal(t, map[string]int{"app": 1, "apr": 1, "ban": 2, "ap": 1, "b": 1}, result)
+	})
+	t.Run("should bucket short strings by their full value", func(t *testing.T) {
+		result := CountByPrefix(New([]string{"go", "go", "gopher"}), 10)
+		assert.Equal(t, map[string]int{"go": 2, "gopher": 1}, result)
+	})
+	t.Run("should count everything under the empty prefix when prefixLen is negative", func(t *testing.T) {
+		result := CountByPrefix(New([]string{"go", "rust", ""}), -1)
+		assert.Equal(t, map[string]int{"": 3}, result)
+	})
+}
+
+func TestTopKByKey(t *testing.T) {
+	type order struct {
+		Customer string
+		Amount   int
+	}
+	t.Run("should keep the top k orders per customer", func(t *testing.T) {
+		orders := []order{
+			{"ana", 10}, {"bob", 5}, {"ana", 50}, {"ana", 30},
+			{"bob", 70}, {"ana", 20}, {"cid", 1},
+		}
+		result := TopKByKey(New(orders),
+			func(o order) string { return o.Customer },
+			2,
+			func(a, b order) bool { return a.Amount > b.Amount },
+		)
+		assert.Equal(t, map[string][]order{
+			"ana": {{"ana", 50}, {"ana", 30}},
+			"bob": {{"bob", 70}, {"bob", 5}},
+			"cid": {{"cid", 1}},
+		}, result)
+	})
+}
+
+func TestBucketSort(t *testing.T) {
+	type score struct {
+		Name  string
+		Value int
+	}
+	byTens := func(s score) int { return s.Value / 10 }
+	t.Run("should fill buckets in a stable order", func(t *testing.T) {
+		scores := []score{{"a", 15}, {"b", 3}, {"c", 12}, {"d", 27}, {"e", 8}}
+		buckets := BucketSort(New(scores), byTens, 3)
+		assert.Equal(t, [][]score{
+			{{"b", 3}, {"e", 8}},
+			{{"a", 15}, {"c", 12}},
+			{{"d", 27}},
+		}, buckets)
+	})
+	t.Run("should drop out-of-range indices", func(t *testing.T) {
+		buckets := BucketSort(New([]score{{"a", 99}, {"b", -15}, {"c", 5}}), byTens, 2)
+		assert.Equal(t, [][]score{{{"c", 5}}, nil}, buckets)
+	})
+}
+
+func TestBuildTree(t *testing.T) {
+	type node struct {
+		ID     int
+		Parent int
+	}
+	rows := []node{{1, 0}, {2, 1}, {3, 1}, {4, 2}, {5, 5}}
+	id := func(n node) int { return n.ID }
+	parent := func(n node) (int, bool) { return n.Parent, n.Parent != 0 }
+	t.Run("should index children by parent id", func(t *testing.T) {
+		children := BuildTree(New(rows), id, parent)
+		assert.Equal(t, map[int][]node{
+			1: {{2, 1}, {3, 1}},
+			2: {{4, 2}},
+		}, children)
+	})
+	t.Run("should leave roots out of the children lists", func(t *testing.T) {
+		children := BuildTree(New(rows), id, parent)
+		for _, list := range children {
+			for _, child := range list {
+				assert.NotEqual(t, 1, child.ID)
+				assert.NotEqual(t, 5, child.ID)
+			}
+		}
+	})
+}
+
+func TestGroupBy(t *testing.T) {
+	type person struct {
+		Name       string
+		Department string
+	}
+	t.Run("should group people by department in order", func(t *testing.T) {
+		people := []person{
+			{"Ana", "eng"}, {"Bia", "sales"}, {"Caio", "eng"}, {"Duda", "hr"}, {"Enzo", "eng"},
+		}
+		groups := GroupBy(New(people), func(p person) string { return p.Department })
+		assert.Len(t, groups, 3)
+		assert.Equal(t, []person{{"Ana", "eng"}, {"Caio", "eng"}, {"Enzo", "eng"}}, groups["eng"])
+		assert.Equal(t, []person{{"Bia", "sales"}}, groups["sales"])
+		assert.Equal(t, []person{{"Duda", "hr"}}, groups["hr"])
+	})
+	t.Run("should return an empty non-nil map", func(t *testing.T) {
+		groups := GroupBy(New([]person{}), func(p person) string { return p.Department })
+		assert.NotNil(t, groups)
+		assert.Empty(t, groups)
+	})
+}
+
+func TestCrossTab(t *testing.T) {
+	type vote struct {
+		Region string
+		Choice string
+	}
+	t.Run("should count per row and column", func(t *testing.T) {
+		votes := []vote{
+			{"north", "yes"}, {"south", "no"}, {"north", "no"},
+			{"north", "yes"}, {"south", "no"}, {"east", "yes"},
+		}
+		table := CrossTab(New(votes),
+			func(v vote) string { return v.Region },
+			func(v vote) string { return v.Choice },
+		)
+		assert.Equal(t, map[string]map[string]int{
+			"north": {"yes": 2, "no": 1},
+			"south": {"no": 2},
+			"east":  {"yes": 1},
+		}, table)
+	})
+}
+
+func TestToMap(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "ana"}, {2, "bob"}, {1, "ana maria"}}
+	id := func(u user) int { return u.ID }
+	t.Run("should build an id to struct map with last-write-wins", func(t *testing.T) {
+		result := ToMap(New(users), id)
+		assert.Equal(t, map[int]user{1: {1, "ana maria"}, 2: {2, "bob"}}, result)
+	})
+	t.Run("should map keys to extracted values", func(t *testing.T) {
+		result := ToMapWithValue(New(users), id, func(u user) string { return u.Name })
+		assert.Equal(t, map[int]string{1: "ana maria", 2: "bob"}, result)
+	})
+	t.Run("should return an empty non-nil map", func(t *testing.T) {
+		result := ToMap(New([]user{}), id)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
+
+func TestSumByKey(t *testing.T) {
+	type order struct {
+		Customer string
+		Amount   float64
+	}
+	t.Run("should total the amounts per customer", func(t *testing.T) {
+		orders := []order{{"bob", 10}, {"ana", 5}, {"bob", 2.5}, {"cid", 1}, {"ana", 7}}
+		totals := SumByKey(New(orders),
+			func(o order) string { return o.Customer },
+			func(o order) float64 { return o.Amount },
+		).Collect()
+		assert.Equal(t, []Pair[string, float64]{{"bob", 12.5}, {"ana", 12}, {"cid", 1}}, totals)
+	})
+}
@@ -0,0 +1,41 @@
+package compress
+
+// Interval is a closed range of integers from Start to End.
+type Interval struct {
+	Start, End int
+}
+
+// MergeIntervals returns a new Compress with the intervals sorted by start and with every
+// overlapping or touching pair (one starts where the other ends) merged into one.
+func MergeIntervals(c *Compress[Interval]) *Compress[Interval] {
+	sorted := c.Clone().Sort(func(a, b Interval) bool {
+		return a.Start < b.Start
+	})
+	return Coalesce(sorted,
+		func(a, b Interval) bool { return b.Start <= a.End },
+		func(a, b Interval) Interval { return Interval{Start: a.Start, End: max(a.End, b.End)} },
+	)
+}
+
+// IntervalGaps returns the closed ranges of integers within [lo, hi] that none of the intervals cover,
+// in ascending order.
+func IntervalGaps(c *Compress[Interval], lo, hi int) *Compress[Interval] {
+	gaps := make([]Interval, 0)
+	cursor := lo
+	for _, iv := range MergeIntervals(c).data {
+		if cursor > hi {
+			break
+		}
+		if iv.End < cursor {
+			continue
+		}
+		if iv.Start > cursor {
+			gaps = append(gaps, Interval{Start: cursor, End: min(iv.Start-1, hi)})
+		}
+		cursor = max(cursor, iv.End+1)
+	}
+	if cursor <= hi {
+		gaps = append(gaps, Interval{Start: cursor, End: hi})
+	}
+	return &Compress[Interval]{data: gaps}
+}
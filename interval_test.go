@@ -0,0 +1,52 @@
+package compress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeIntervals(t *testing.T) {
+	tests := []struct {
+		name      string
+		intervals []Interval
+		expected  []Interval
+	}{
+		{"overlapping", []Interval{{5, 8}, {1, 3}, {2, 6}}, []Interval{{1, 8}}},
+		{"touching", []Interval{{1, 3}, {3, 5}}, []Interval{{1, 5}}},
+		{"disjoint", []Interval{{6, 7}, {1, 2}, {4, 5}}, []Interval{{1, 2}, {4, 5}, {6, 7}}},
+		{"nested", []Interval{{1, 10}, {2, 3}, {4, 9}}, []Interval{{1, 10}}},
+		{"empty", []Interval{}, []Interval{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MergeIntervals(New(tt.intervals)).Collect())
+		})
+	}
+	t.Run("should not reorder the input", func(t *testing.T) {
+		input := []Interval{{5, 8}, {1, 3}}
+		MergeIntervals(New(input))
+		assert.Equal(t, []Interval{{5, 8}, {1, 3}}, input)
+	})
+}
+
+func TestIntervalGaps(t *testing.T) {
+	t.Run("should find interior and boundary gaps", func(t *testing.T) {
+		busy := []Interval{{3, 4}, {8, 10}, {9, 12}}
+		gaps := IntervalGaps(New(busy), 0, 15).Collect()
+		assert.Equal(t, []Interval{{0, 2}, {5, 7}, {13, 15}}, gaps)
+	})
+	t.Run("should ignore intervals outside the bounds", func(t *testing.T) {
+		busy := []Interval{{-5, 1}, {4, 5}, {20, 30}}
+		gaps := IntervalGaps(New(busy), 0, 10).Collect()
+		assert.Equal(t, []Interval{{2, 3}, {6, 10}}, gaps)
+	})
+	t.Run("should find no gap when fully covered", func(t *testing.T) {
+		gaps := IntervalGaps(New([]Interval{{0, 5}, {6, 10}}), 0, 10).Collect()
+		assert.Empty(t, gaps)
+	})
+	t.Run("should return the whole range without intervals", func(t *testing.T) {
+		gaps := IntervalGaps(New([]Interval{}), 1, 4).Collect()
+		assert.Equal(t, []Interval{{1, 4}}, gaps)
+	})
+}
@@ -0,0 +1,39 @@
+package compress
+
+import "iter"
+
+// All returns an iterator over the elements, for use with range-over-func and the iter package.
+// Iteration stops as soon as the consumer breaks.
+func (c *Compress[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, elem := range c.data {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over index/value pairs.
+// Iteration stops as soon as the consumer breaks.
+func (c *Compress[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, elem := range c.data {
+			if !yield(i, elem) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates a new Compress by draining seq. A nil sequence yields an empty Compress.
+func FromSeq[T any](seq iter.Seq[T]) *Compress[T] {
+	data := make([]T, 0)
+	if seq == nil {
+		return &Compress[T]{data: data}
+	}
+	for elem := range seq {
+		data = append(data, elem)
+	}
+	return &Compress[T]{data: data}
+}
@@ -0,0 +1,62 @@
+package compress
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	t.Run("should yield every element", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(New([]int{1, 2, 3}).All()))
+	})
+	t.Run("should stop when the consumer breaks", func(t *testing.T) {
+		visited := make([]int, 0)
+		for v := range New([]int{1, 2, 3, 4}).All() {
+			if v == 3 {
+				break
+			}
+			visited = append(visited, v)
+		}
+		assert.Equal(t, []int{1, 2}, visited)
+	})
+}
+
+func TestSeq2(t *testing.T) {
+	t.Run("should yield indices and values", func(t *testing.T) {
+		indices := make([]int, 0)
+		values := make([]string, 0)
+		for i, v := range New([]string{"a", "b", "c"}).Seq2() {
+			indices = append(indices, i)
+			values = append(values, v)
+		}
+		assert.Equal(t, []int{0, 1, 2}, indices)
+		assert.Equal(t, []string{"a", "b", "c"}, values)
+	})
+	t.Run("should stop when the consumer breaks", func(t *testing.T) {
+		last := -1
+		for i := range New([]string{"a", "b", "c"}).Seq2() {
+			last = i
+			if i == 1 {
+				break
+			}
+		}
+		assert.Equal(t, 1, last)
+	})
+}
+
+func TestFromSeq(t *testing.T) {
+	t.Run("should round-trip through All", func(t *testing.T) {
+		c := New([]string{"x", "y", "z"})
+		assert.Equal(t, c.Collect(), FromSeq(c.All()).Collect())
+	})
+	t.Run("should drain standard library iterators", func(t *testing.T) {
+		assert.Equal(t, []int{3, 1, 2}, FromSeq(slices.Values([]int{3, 1, 2})).Collect())
+	})
+	t.Run("should handle a nil sequence", func(t *testing.T) {
+		result := FromSeq[int](nil)
+		assert.NotNil(t, result)
+		assert.Empty(t, result.Collect())
+	})
+}
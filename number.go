@@ -0,0 +1,104 @@
+package compress
+
+import "cmp"
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the total of the elements, or zero for an empty slice.
+func Sum[T Number](c *Compress[T]) T {
+	var total T
+	for _, v := range c.data {
+		total += v
+	}
+	return total
+}
+
+// Average returns the arithmetic mean of the elements, or 0 for an empty slice.
+func Average[T Number](c *Compress[T]) float64 {
+	if len(c.data) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, v := range c.data {
+		total += float64(v)
+	}
+	return total / float64(len(c.data))
+}
+
+// Min returns the smallest element. ok is false if the slice is nil or empty.
+func Min[T cmp.Ordered](c *Compress[T]) (value T, ok bool) {
+	if len(c.data) == 0 {
+		return value, false
+	}
+	value = c.data[0]
+	for _, v := range c.data[1:] {
+		if v < value {
+			value = v
+		}
+	}
+	return value, true
+}
+
+// Max returns the largest element. ok is false if the slice is nil or empty.
+func Max[T cmp.Ordered](c *Compress[T]) (value T, ok bool) {
+	if len(c.data) == 0 {
+		return value, false
+	}
+	value = c.data[0]
+	for _, v := range c.data[1:] {
+		if v > value {
+			value = v
+		}
+	}
+	return value, true
+}
+
+// MinBy returns the element with the smallest key. On ties the first-seen element wins.
+// ok is false if the slice is nil or empty.
+func MinBy[T any, K cmp.Ordered](c *Compress[T], key func(T) K) (value T, ok bool) {
+	return extremeBy(c, key, func(a, b K) bool { return a < b })
+}
+
+// MaxBy returns the element with the largest key. On ties the first-seen element wins.
+// ok is false if the slice is nil or empty.
+func MaxBy[T any, K cmp.Ordered](c *Compress[T], key func(T) K) (value T, ok bool) {
+	return extremeBy(c, key, func(a, b K) bool { return a > b })
+}
+
+// extremeBy returns the first element whose key beats every other key according to better.
+func extremeBy[T any, K cmp.Ordered](c *Compress[T], key func(T) K, better func(a, b K) bool) (value T, ok bool) {
+	if len(c.data) == 0 {
+		return value, false
+	}
+	value = c.data[0]
+	best := key(value)
+	for _, elem := range c.data[1:] {
+		if k := key(elem); better(k, best) {
+			value, best = elem, k
+		}
+	}
+	return value, true
+}
+
+// ProductExceptSelf returns a new Compress where each position holds the product of every other element.
+// It multiplies prefix and suffix products instead of dividing, so zeros are handled correctly.
+func ProductExceptSelf[T Number](c *Compress[T]) *Compress[T] {
+	prefix, suffix := PrefixSuffix(c, 1, func(acc, cur T) T { return acc * cur })
+	result := make([]T, len(c.data))
+	for i := range result {
+		product := T(1)
+		if i > 0 {
+			product *= prefix[i-1]
+		}
+		if i < len(result)-1 {
+			product *= suffix[i+1]
+		}
+		result[i] = product
+	}
+	return &Compress[T]{data: result}
+}
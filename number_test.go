@@ -0,0 +1,76 @@
+package compress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductExceptSelf(t *testing.T) {
+	t.Run("should multiply every other element", func(t *testing.T) {
+		assert.Equal(t, []int{24, 12, 8, 6}, ProductExceptSelf(New([]int{1, 2, 3, 4})).Collect())
+	})
+	t.Run("should handle a single zero", func(t *testing.T) {
+		assert.Equal(t, []int{0, 12, 0, 0}, ProductExceptSelf(New([]int{2, 0, 3, 2})).Collect())
+	})
+	t.Run("should handle multiple zeros", func(t *testing.T) {
+		assert.Equal(t, []int{0, 0, 0}, ProductExceptSelf(New([]int{0, 5, 0})).Collect())
+	})
+	t.Run("should return empty for an empty slice", func(t *testing.T) {
+		assert.Empty(t, ProductExceptSelf(New([]float64{})).Collect())
+	})
+}
+
+func TestSum(t *testing.T) {
+	t.Run("should sum ints and floats", func(t *testing.T) {
+		assert.Equal(t, 10, Sum(New([]int{1, 2, 3, 4})))
+		assert.InDelta(t, 4.0, Sum(New([]float64{1.5, 2.5})), 1e-12)
+	})
+	t.Run("should average ints and floats", func(t *testing.T) {
+		assert.Equal(t, 2.5, Average(New([]int{1, 2, 3, 4})))
+		assert.InDelta(t, 0.2, Average(New([]float64{0.1, 0.3})), 1e-12)
+		assert.Equal(t, 0.0, Average(New([]int{})))
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("should find the extremes", func(t *testing.T) {
+		minInt, ok := Min(New([]int{3, -1, 7}))
+		assert.True(t, ok)
+		assert.Equal(t, -1, minInt)
+		maxFloat, ok := Max(New([]float64{0.5, 2.25, -3}))
+		assert.True(t, ok)
+		assert.Equal(t, 2.25, maxFloat)
+	})
+	t.Run("should report not ok for an empty slice", func(t *testing.T) {
+		_, ok := Min(New([]int{}))
+		assert.False(t, ok)
+		_, ok = Max(New([]float64{}))
+		assert.False(t, ok)
+	})
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type person struct {
+		Name string
+		Age  int
+	}
+	age := func(p person) int { return p.Age }
+	people := []person{{"Ana", 31}, {"Bia", 19}, {"Caio", 64}, {"Duda", 19}, {"Enzo", 64}}
+	t.Run("should find the youngest, first on ties", func(t *testing.T) {
+		youngest, ok := MinBy(New(people), age)
+		assert.True(t, ok)
+		assert.Equal(t, person{"Bia", 19}, youngest)
+	})
+	t.Run("should find the oldest, first on ties", func(t *testing.T) {
+		oldest, ok := MaxBy(New(people), age)
+		assert.True(t, ok)
+		assert.Equal(t, person{"Caio", 64}, oldest)
+	})
+	t.Run("should report not ok for an empty slice", func(t *testing.T) {
+		_, ok := MinBy(New([]person{}), age)
+		assert.False(t, ok)
+		_, ok = MaxBy(New([]person{}), age)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,30 @@
+package compress
+
+import (
+	"cmp"
+	"sort"
+)
+
+// Pair holds a key and its associated value.
+type Pair[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// FromMap creates a Compress of key/value pairs from a map.
+// The order of the pairs is unspecified; use SortByKey for a deterministic order.
+func FromMap[K comparable, V any](m map[K]V) *Compress[Pair[K, V]] {
+	data := make([]Pair[K, V], 0, len(m))
+	for key, value := range m {
+		data = append(data, Pair[K, V]{Key: key, Value: value})
+	}
+	return &Compress[Pair[K, V]]{data: data}
+}
+
+// SortByKey sorts the pairs in place by ascending key and returns the receiver.
+func SortByKey[K cmp.Ordered, V any](c *Compress[Pair[K, V]]) *Compress[Pair[K, V]] {
+	sort.SliceStable(c.data, func(i, j int) bool {
+		return c.data[i].Key < c.data[j].Key
+	})
+	return c
+}
@@ -0,0 +1,26 @@
+package compress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromMap(t *testing.T) {
+	stock := map[string]int{"pear": 3, "apple": 5, "fig": 1}
+	t.Run("should contain every pair", func(t *testing.T) {
+		pairs := FromMap(stock).Collect()
+		assert.ElementsMatch(t, []Pair[string, int]{
+			{"pear", 3}, {"apple", 5}, {"fig", 1},
+		}, pairs)
+	})
+	t.Run("should sort pairs by key", func(t *testing.T) {
+		pairs := SortByKey(FromMap(stock)).Collect()
+		assert.Equal(t, []Pair[string, int]{
+			{"apple", 5}, {"fig", 1}, {"pear", 3},
+		}, pairs)
+	})
+	t.Run("should return empty for an empty map", func(t *testing.T) {
+		assert.Empty(t, FromMap(map[string]int{}).Collect())
+	})
+}
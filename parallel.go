@@ -0,0 +1,173 @@
+package compress
+
+import "sync"
+
+// partitions splits the range [0, n) into at most workers contiguous [start, end) bounds.
+func partitions(n, workers int) [][2]int {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > n {
+		workers = n
+	}
+	if n == 0 {
+		return nil
+	}
+	bounds := make([][2]int, 0, workers)
+	size, rest := n/workers, n%workers
+	start := 0
+	for i := 0; i < workers; i++ {
+		end := start + size
+		if i < rest {
+			end++
+		}
+		bounds = append(bounds, [2]int{start, end})
+		start = end
+	}
+	return bounds
+}
+
+// MapReduce splits the slice across workers, maps and reduces each partition locally
+// and then combines the partial results in partition order.
+// combine must be associative and identity must be its neutral element.
+func MapReduce[T, A any](c *Compress[T], workers int, mapper func(T) A, identity A, combine func(A, A) A) A {
+	bounds := partitions(len(c.data), workers)
+	partials := make([]A, len(bounds))
+	var wg sync.WaitGroup
+	wg.Add(len(bounds))
+	for i, bound := range bounds {
+		go func(i int, part []T) {
+			defer wg.Done()
+			acc := identity
+			for _, item := range part {
+				acc = combine(acc, mapper(item))
+			}
+			partials[i] = acc
+		}(i, c.data[bound[0]:bound[1]])
+	}
+	wg.Wait()
+	result := identity
+	for _, partial := range partials {
+		result = combine(result, partial)
+	}
+	return result
+}
+
+// ParallelScan computes the inclusive prefix scan of the slice across workers goroutines.
+// It runs in two sweeps: an up-sweep reduces every partition to its total, and after the
+// partition totals are scanned, a down-sweep rescans each partition from its offset.
+// combine must be associative and identity must be its neutral element, otherwise the
+// result depends on how the slice was partitioned.
+func ParallelScan[T any](c *Compress[T], workers int, identity T, combine func(T, T) T) *Compress[T] {
+	bounds := partitions(len(c.data), workers)
+	totals := make([]T, len(bounds))
+	var wg sync.WaitGroup
+	wg.Add(len(bounds))
+	for i, bound := range bounds {
+		go func(i int, part []T) {
+			defer wg.Done()
+			acc := identity
+			for _, item := range part {
+				acc = combine(acc, item)
+			}
+			totals[i] = acc
+		}(i, c.data[bound[0]:bound[1]])
+	}
+	wg.Wait()
+
+	offsets := make([]T, len(bounds))
+	acc := identity
+	for i, total := range totals {
+		offsets[i] = acc
+		acc = combine(acc, total)
+	}
+
+	result := make([]T, len(c.data))
+	wg.Add(len(bounds))
+	for i, bound := range bounds {
+		go func(i, start, end int) {
+			defer wg.Done()
+			acc := offsets[i]
+			for j := start; j < end; j++ {
+				acc = combine(acc, c.data[j])
+				result[j] = acc
+			}
+		}(i, bound[0], bound[1])
+	}
+	wg.Wait()
+	return &Compress[T]{data: result}
+}
+
+// GroupMapParallel groups the elements by keyFn and then runs mapGroup on the groups
+// concurrently across workers goroutines. Results follow the order in which keys first appeared.
+func GroupMapParallel[T any, K comparable, R any](c *Compress[T], workers int, keyFn func(T) K, mapGroup func(K, []T) R) *Compress[R] {
+	keys, groups := OrderedGroupBy(c, keyFn)
+	result := make([]R, len(keys))
+	indices := make(chan int)
+	workers = max(min(workers, len(keys)), 1)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				result[i] = mapGroup(keys[i], groups[keys[i]])
+			}
+		}()
+	}
+	for i := range keys {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+	return &Compress[R]{data: result}
+}
+
+// FilterParallel filters contiguous partitions of the slice across workers goroutines and
+// concatenates the survivors in partition order, so the result matches the sequential Filter.
+func FilterParallel[T any](c *Compress[T], workers int, predicate func(T) bool) *Compress[T] {
+	bounds := partitions(len(c.data), workers)
+	kept := make([][]T, len(bounds))
+	var wg sync.WaitGroup
+	wg.Add(len(bounds))
+	for i, bound := range bounds {
+		go func(i int, part []T) {
+			defer wg.Done()
+			survivors := make([]T, 0, len(part))
+			for _, item := range part {
+				if predicate(item) {
+					survivors = append(survivors, item)
+				}
+			}
+			kept[i] = survivors
+		}(i, c.data[bound[0]:bound[1]])
+	}
+	wg.Wait()
+	result := make([]T, 0)
+	for _, survivors := range kept {
+		result = append(result, survivors...)
+	}
+	return &Compress[T]{data: result}
+}
+
+// MapParallel applies f to every element across workers goroutines, writing each result back to
+// its own index so the order is preserved, and returns the receiver. Each goroutine owns a disjoint
+// partition of indices. With workers <= 1 it falls back to the sequential Map.
+func (c *Compress[T]) MapParallel(workers int, f func(T) T) *Compress[T] {
+	if workers <= 1 {
+		return c.Map(f)
+	}
+	var wg sync.WaitGroup
+	bounds := partitions(len(c.data), workers)
+	wg.Add(len(bounds))
+	for _, bound := range bounds {
+		go func(part []T) {
+			defer wg.Done()
+			for i, item := range part {
+				part[i] = f(item)
+			}
+		}(c.data[bound[0]:bound[1]])
+	}
+	wg.Wait()
+	return c
+}
@@ -0,0 +1,149 @@
+package compress
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapReduce(t *testing.T) {
+	data := make([]int, 10000)
+	expected := 0
+	for i := range data {
+		data[i] = i + 1
+		expected += data[i] * data[i]
+	}
+	square := func(x int) int { return x * x }
+	sum := func(a, b int) int { return a + b }
+	for _, workers := range []int{0, 1, 3, 8, 20000} {
+		t.Run(fmt.Sprintf("should compute the sum of squares with workers=%d", workers), func(t *testing.T) {
+			result := MapReduce(New(data), workers, square, 0, sum)
+			assert.Equal(t, expected, result)
+		})
+	}
+	t.Run("should return identity for an empty slice", func(t *testing.T) {
+		assert.Equal(t, 0, MapReduce(New([]int{}), 4, square, 0, sum))
+	})
+}
+
+func TestParallelScan(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+	t.Run("should match the sequential scan", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		data := make([]int, 100000)
+		for i := range data {
+			data[i] = r.Intn(1000) - 500
+		}
+		expected := ScanFold(New(data), 0, sum).Collect()
+		for _, workers := range []int{1, 4, 7} {
+			assert.Equal(t, expected, ParallelScan(New(data), workers, 0, sum).Collect(), "workers=%d", workers)
+		}
+	})
+	t.Run("should return empty for an empty slice", func(t *testing.T) {
+		assert.Empty(t, ParallelScan(New([]int{}), 4, 0, sum).Collect())
+	})
+}
+
+func slowGroupSum(_ int, group []int) int {
+	time.Sleep(2 * time.Millisecond)
+	total := 0
+	for _, x := range group {
+		total += x
+	}
+	return total
+}
+
+func TestGroupMapParallel(t *testing.T) {
+	t.Run("should map groups in first-seen key order", func(t *testing.T) {
+		data := []int{3, 10, 4, 21, 13, 25, 1}
+		result := GroupMapParallel(New(data), 3, func(x int) int { return x / 10 }, slowGroupSum)
+		assert.Equal(t, []int{8, 23, 46}, result.Collect())
+	})
+	t.Run("should return empty for an empty slice", func(t *testing.T) {
+		result := GroupMapParallel(New([]int{}), 3, func(x int) int { return x }, slowGroupSum)
+		assert.Empty(t, result.Collect())
+	})
+}
+
+func benchmarkGroupMap(b *testing.B, workers int) {
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = i
+	}
+	for i := 0; i < b.N; i++ {
+		GroupMapParallel(New(data), workers, func(x int) int { return x % 16 }, slowGroupSum)
+	}
+}
+
+func BenchmarkGroupMapSequential(b *testing.B) { benchmarkGroupMap(b, 1) }
+
+func BenchmarkGroupMapParallel(b *testing.B) { benchmarkGroupMap(b, 8) }
+
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for d := 2; d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFilterParallel(t *testing.T) {
+	data := make([]int, 5000)
+	for i := range data {
+		data[i] = len(data) - i
+	}
+	t.Run("should match the sequential filter", func(t *testing.T) {
+		expected := New(append([]int(nil), data...)).Filter(isPrime).Collect()
+		for _, workers := range []int{1, 3, 8} {
+			assert.Equal(t, expected, FilterParallel(New(data), workers, isPrime).Collect(), "workers=%d", workers)
+		}
+	})
+	t.Run("should return empty for an empty slice", func(t *testing.T) {
+		assert.Empty(t, FilterParallel(New([]int{}), 4, isPrime).Collect())
+	})
+}
+
+func benchmarkFilter(b *testing.B, workers int) {
+	data := make([]int, 200000)
+	for i := range data {
+		data[i] = 1_000_000 + i
+	}
+	for i := 0; i < b.N; i++ {
+		FilterParallel(New(data), workers, isPrime)
+	}
+}
+
+func BenchmarkFilterSequential(b *testing.B) { benchmarkFilter(b, 1) }
+
+func BenchmarkFilterParallel(b *testing.B) { benchmarkFilter(b, 8) }
+
+func TestMapParallel(t *testing.T) {
+	square := func(x int) int { return x * x }
+	data := func() []int {
+		values := make([]int, 1000)
+		for i := range values {
+			values[i] = i - 500
+		}
+		return values
+	}
+	t.Run("should match the sequential Map", func(t *testing.T) {
+		expected := New(data()).Map(square).Collect()
+		for _, workers := range []int{-1, 1, 4, 2000} {
+			assert.Equal(t, expected, New(data()).MapParallel(workers, square).Collect(), "workers=%d", workers)
+		}
+	})
+	t.Run("should preserve order with a slow mapper", func(t *testing.T) {
+		result := New([]int{5, 1, 4, 2, 3}).MapParallel(3, func(x int) int {
+			time.Sleep(time.Duration(x) * time.Millisecond)
+			return x * 10
+		}).Collect()
+		assert.Equal(t, []int{50, 10, 40, 20, 30}, result)
+	})
+}
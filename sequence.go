@@ -0,0 +1,130 @@
+package compress
+
+import "sort"
+
+// LongestRun returns the value, length and start index of the longest run of consecutive equal elements.
+// On ties the first run wins. For a nil or empty slice it returns the zero value of T, 0 and -1.
+func LongestRun[T comparable](c *Compress[T]) (value T, length int, startIndex int) {
+	startIndex = -1
+	runStart := 0
+	for i := 1; i <= len(c.data); i++ {
+		if i < len(c.data) && c.data[i] == c.data[runStart] {
+			continue
+		}
+		if i-runStart > length {
+			value, length, startIndex = c.data[runStart], i-runStart, runStart
+		}
+		runStart = i
+	}
+	return value, length, startIndex
+}
+
+// Monotonic reports whether the sequence is non-decreasing and whether it is non-increasing according to less.
+// A constant sequence, and any sequence with fewer than two elements, is both.
+func Monotonic[T any](c *Compress[T], less func(a, b T) bool) (increasing bool, decreasing bool) {
+	increasing, decreasing = true, true
+	for i := 1; i < len(c.data); i++ {
+		if less(c.data[i], c.data[i-1]) {
+			increasing = false
+		}
+		if less(c.data[i-1], c.data[i]) {
+			decreasing = false
+		}
+	}
+	return increasing, decreasing
+}
+
+// SplitOn splits the data into segments separated by the elements matching isDelimiter,
+// which are dropped. Like strings.Split, n delimiters always produce n+1 segments, so leading,
+// trailing or adjacent delimiters yield empty segments.
+func SplitOn[T any](c *Compress[T], isDelimiter func(T) bool) [][]T {
+	segments := make([][]T, 0)
+	current := make([]T, 0)
+	for _, elem := range c.data {
+		if isDelimiter(elem) {
+			segments = append(segments, current)
+			current = make([]T, 0)
+			continue
+		}
+		current = append(current, elem)
+	}
+	return append(segments, current)
+}
+
+// Coalesce walks the slice and merges each element into the previous result while canMerge
+// reports true, returning a new Compress with the merged elements.
+func Coalesce[T any](c *Compress[T], canMerge func(a, b T) bool, merge func(a, b T) T) *Compress[T] {
+	result := make([]T, 0, len(c.data))
+	for _, elem := range c.data {
+		if last := len(result) - 1; last >= 0 && canMerge(result[last], elem) {
+			result[last] = merge(result[last], elem)
+			continue
+		}
+		result = append(result, elem)
+	}
+	return &Compress[T]{data: result}
+}
+
+// FillForward replaces, in place, every element reported by isMissing with clone of the
+// previous non-missing element, and returns the receiver. Leading missing elements have
+// nothing to copy from and are left as they are.
+func FillForward[T any](c *Compress[T], isMissing func(T) bool, clone func(prev T) T) *Compress[T] {
+	last := -1
+	for i, elem := range c.data {
+		if !isMissing(elem) {
+			last = i
+			continue
+		}
+		if last >= 0 {
+			c.data[i] = clone(c.data[last])
+		}
+	}
+	return c
+}
+
+// LongestIncreasingSubsequence returns one longest strictly increasing subsequence according to less,
+// using patience sorting in O(n log n).
+func LongestIncreasingSubsequence[T any](c *Compress[T], less func(a, b T) bool) *Compress[T] {
+	// tails[k] is the index of the smallest tail of an increasing subsequence of length k+1.
+	tails := make([]int, 0)
+	prev := make([]int, len(c.data))
+	for i, elem := range c.data {
+		pile := sort.Search(len(tails), func(k int) bool {
+			return !less(c.data[tails[k]], elem)
+		})
+		prev[i] = -1
+		if pile > 0 {
+			prev[i] = tails[pile-1]
+		}
+		if pile == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[pile] = i
+		}
+	}
+	result := make([]T, len(tails))
+	if len(tails) > 0 {
+		for i, k := tails[len(tails)-1], len(tails)-1; i >= 0; i, k = prev[i], k-1 {
+			result[k] = c.data[i]
+		}
+	}
+	return &Compress[T]{data: result}
+}
+
+// PrefixSuffix returns the running reductions from the left (prefix) and from the right (suffix).
+// prefix[i] folds initial with elements 0..i and suffix[i] folds initial with elements n-1 down to i.
+func PrefixSuffix[T any](c *Compress[T], initial T, reducer func(acc, cur T) T) (prefix, suffix []T) {
+	prefix = make([]T, len(c.data))
+	suffix = make([]T, len(c.data))
+	acc := initial
+	for i, elem := range c.data {
+		acc = reducer(acc, elem)
+		prefix[i] = acc
+	}
+	acc = initial
+	for i := len(c.data) - 1; i >= 0; i-- {
+		acc = reducer(acc, c.data[i])
+		suffix[i] = acc
+	}
+	return prefix, suffix
+}
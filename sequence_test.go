@@ -0,0 +1,134 @@
+package compress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLongestRun(t *testing.T) {
+	t.Run("should find the longest run", func(t *testing.T) {
+		value, length, start := LongestRun(New([]int{1, 2, 2, 3, 3, 3, 2}))
+		assert.Equal(t, 3, value)
+		assert.Equal(t, 3, length)
+		assert.Equal(t, 3, start)
+	})
+	t.Run("should keep the first run on ties", func(t *testing.T) {
+		value, length, start := LongestRun(New([]string{"a", "b", "b", "c", "c"}))
+		assert.Equal(t, "b", value)
+		assert.Equal(t, 2, length)
+		assert.Equal(t, 1, start)
+	})
+	t.Run("should report nothing for an empty slice", func(t *testing.T) {
+		value, length, start := LongestRun(New([]int{}))
+		assert.Equal(t, 0, value)
+		assert.Equal(t, 0, length)
+		assert.Equal(t, -1, start)
+	})
+}
+
+func TestMonotonic(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	cases := []struct {
+		name       string
+		data       []int
+		increasing bool
+		decreasing bool
+	}{
+		{"increasing", []int{1, 2, 2, 5}, true, false},
+		{"decreasing", []int{9, 4, 4, 1}, false, true},
+		{"constant", []int{3, 3, 3}, true, true},
+		{"unsorted", []int{1, 3, 2}, false, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			increasing, decreasing := Monotonic(New(tc.data), less)
+			assert.Equal(t, tc.increasing, increasing)
+			assert.Equal(t, tc.decreasing, decreasing)
+		})
+	}
+}
+
+func TestSplitOn(t *testing.T) {
+	t.Run("should split on zero values", func(t *testing.T) {
+		result := SplitOn(New([]int{1, 2, 0, 3, 0, 4, 5}), func(x int) bool { return x == 0 })
+		assert.Equal(t, [][]int{{1, 2}, {3}, {4, 5}}, result)
+	})
+	t.Run("should produce empty segments around edge delimiters", func(t *testing.T) {
+		result := SplitOn(New([]string{"|", "a", "|", "|", "b", "|"}), func(s string) bool { return s == "|" })
+		assert.Equal(t, [][]string{{}, {"a"}, {}, {"b"}, {}}, result)
+	})
+	t.Run("should return one segment without delimiters", func(t *testing.T) {
+		result := SplitOn(New([]int{1, 2}), func(x int) bool { return x == 0 })
+		assert.Equal(t, [][]int{{1, 2}}, result)
+	})
+}
+
+func TestCoalesce(t *testing.T) {
+	type span struct {
+		From, To int
+	}
+	t.Run("should merge overlapping ranges", func(t *testing.T) {
+		spans := []span{{1, 3}, {2, 5}, {4, 6}, {8, 9}, {9, 12}, {15, 16}}
+		result := Coalesce(New(spans),
+			func(a, b span) bool { return b.From <= a.To },
+			func(a, b span) span { return span{a.From, max(a.To, b.To)} },
+		).Collect()
+		assert.Equal(t, []span{{1, 6}, {8, 12}, {15, 16}}, result)
+	})
+}
+
+func TestFillForward(t *testing.T) {
+	t.Run("should fill interior gaps and keep leading ones", func(t *testing.T) {
+		readings := []*float64{nil, ptr(1.5), nil, nil, ptr(3.0), nil}
+		result := FillForward(New(readings),
+			func(v *float64) bool { return v == nil },
+			func(prev *float64) *float64 { return ptr(*prev) },
+		).Collect()
+		values := make([]any, len(result))
+		for i, v := range result {
+			if v != nil {
+				values[i] = *v
+			}
+		}
+		assert.Equal(t, []any{nil, 1.5, 1.5, 1.5, 3.0, 3.0}, values)
+		assert.NotSame(t, result[1], result[2])
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestLongestIncreasingSubsequence(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	t.Run("should find a longest strictly increasing subsequence", func(t *testing.T) {
+		data := []int{10, 9, 2, 5, 3, 7, 101, 18, 4, 19}
+		result := LongestIncreasingSubsequence(New(data), less).Collect()
+		assert.Len(t, result, 5)
+		for i := 1; i < len(result); i++ {
+			assert.Less(t, result[i-1], result[i])
+		}
+		next := 0
+		for _, x := range data {
+			if next < len(result) && x == result[next] {
+				next++
+			}
+		}
+		assert.Equal(t, len(result), next, "result must be a subsequence of the input")
+	})
+	t.Run("should not count equal elements as increasing", func(t *testing.T) {
+		assert.Equal(t, []int{2}, LongestIncreasingSubsequence(New([]int{2, 2, 2}), less).Collect())
+	})
+	t.Run("should return empty for an empty slice", func(t *testing.T) {
+		assert.Empty(t, LongestIncreasingSubsequence(New([]int{}), less).Collect())
+	})
+}
+
+func TestPrefixSuffix(t *testing.T) {
+	t.Run("should compute prefix and suffix sums", func(t *testing.T) {
+		prefix, suffix := PrefixSuffix(New([]int{1, 2, 3, 4}), 0, func(acc, cur int) int { return acc + cur })
+		assert.Equal(t, []int{1, 3, 6, 10}, prefix)
+		assert.Equal(t, []int{10, 9, 7, 4}, suffix)
+	})
+}
@@ -0,0 +1,178 @@
+package compress
+
+// toSet returns the distinct elements of data as a membership set.
+func toSet[T comparable](data []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(data))
+	for _, elem := range data {
+		set[elem] = struct{}{}
+	}
+	return set
+}
+
+// DistinctFunc removes, in place, every element equal according to eq to an earlier kept element,
+// and returns the receiver. It compares each element against all kept ones, so it runs in O(n²);
+// prefer DistinctBy when a comparable key exists.
+func DistinctFunc[T any](c *Compress[T], eq func(a, b T) bool) *Compress[T] {
+	if len(c.data) == 0 {
+		return c
+	}
+	result := make([]T, 0, len(c.data))
+	for _, elem := range c.data {
+		duplicate := false
+		for _, kept := range result {
+			if eq(kept, elem) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			result = append(result, elem)
+		}
+	}
+	c.data = result
+	return c
+}
+
+// Union returns the distinct elements of a followed by those of b, in first-seen order.
+func Union[T comparable](a, b *Compress[T]) *Compress[T] {
+	data := make([]T, 0, len(a.data)+len(b.data))
+	data = append(data, a.data...)
+	data = append(data, b.data...)
+	return Distinct(&Compress[T]{data: data})
+}
+
+// Intersect returns the distinct elements of a that also appear in b, in a's first-seen order.
+func Intersect[T comparable](a, b *Compress[T]) *Compress[T] {
+	inB := toSet(b.data)
+	return Distinct(a.Clone().Filter(func(elem T) bool {
+		_, ok := inB[elem]
+		return ok
+	}))
+}
+
+// Difference returns the distinct elements of a that do not appear in b, in a's first-seen order.
+func Difference[T comparable](a, b *Compress[T]) *Compress[T] {
+	inB := toSet(b.data)
+	return Distinct(a.Clone().Filter(func(elem T) bool {
+		_, ok := inB[elem]
+		return !ok
+	}))
+}
+
+// SymmetricDifference returns the distinct elements present in exactly one of a and b,
+// those of a first and then those of b, each in first-seen order.
+func SymmetricDifference[T comparable](a, b *Compress[T]) *Compress[T] {
+	inA, inB := toSet(a.data), toSet(b.data)
+	result := make([]T, 0)
+	seen := make(map[T]struct{})
+	collect := func(data []T, other map[T]struct{}) {
+		for _, elem := range data {
+			if _, ok := other[elem]; ok {
+				continue
+			}
+			if _, ok := seen[elem]; ok {
+				continue
+			}
+			seen[elem] = struct{}{}
+			result = append(result, elem)
+		}
+	}
+	collect(a.data, inB)
+	collect(b.data, inA)
+	return &Compress[T]{data: result}
+}
+
+// Jaccard returns |A∩B| / |A∪B| over the distinct elements of a and b.
+// Two empty inputs are considered identical and yield 1.0.
+func Jaccard[T comparable](a, b *Compress[T]) float64 {
+	inA, inB := toSet(a.data), toSet(b.data)
+	intersection := 0
+	for elem := range inA {
+		if _, ok := inB[elem]; ok {
+			intersection++
+		}
+	}
+	union := len(inA) + len(inB) - intersection
+	if union == 0 {
+		return 1.0
+	}
+	return float64(intersection) / float64(union)
+}
+
+// Distinct removes duplicate elements in place, keeping the first occurrence of each, and returns the receiver.
+// It is a package-level function because T must be comparable.
+func Distinct[T comparable](c *Compress[T]) *Compress[T] {
+	return DistinctBy(c, func(elem T) T { return elem })
+}
+
+// DistinctBy removes elements whose key was already seen, keeping the first occurrence of each key,
+// and returns the receiver. It works for element types that are not comparable themselves.
+func DistinctBy[T any, K comparable](c *Compress[T], key func(T) K) *Compress[T] {
+	if len(c.data) == 0 {
+		return c
+	}
+	seen := make(map[K]struct{}, len(c.data))
+	result := make([]T, 0, len(c.data))
+	for _, elem := range c.data {
+		k := key(elem)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		result = append(result, elem)
+	}
+	c.data = result
+	return c
+}
+
+// Contains reports whether target is present in the slice.
+func Contains[T comparable](c *Compress[T], target T) bool {
+	return IndexOf(c, target) >= 0
+}
+
+// IndexOf returns the index of the first occurrence of target, or -1 if it is absent.
+func IndexOf[T comparable](c *Compress[T], target T) int {
+	for i, elem := range c.data {
+		if elem == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// GreedyCover repeatedly selects the element whose covers set adds the most not-yet-covered items of
+// universe, until the universe is covered or no element makes progress. Ties go to the earliest element.
+// The selected elements are returned in pick order.
+func GreedyCover[T any](c *Compress[T], covers func(T) map[string]struct{}, universe map[string]struct{}) *Compress[T] {
+	sets := make([]map[string]struct{}, len(c.data))
+	for i, elem := range c.data {
+		sets[i] = covers(elem)
+	}
+	uncovered := make(map[string]struct{}, len(universe))
+	for item := range universe {
+		uncovered[item] = struct{}{}
+	}
+	picked := make([]T, 0)
+	for len(uncovered) > 0 {
+		best, bestGain := -1, 0
+		for i, set := range sets {
+			gain := 0
+			for item := range set {
+				if _, ok := uncovered[item]; ok {
+					gain++
+				}
+			}
+			if gain > bestGain {
+				best, bestGain = i, gain
+			}
+		}
+		if best < 0 {
+			break
+		}
+		for item := range sets[best] {
+			delete(uncovered, item)
+		}
+		picked = append(picked, c.data[best])
+	}
+	return &Compress[T]{data: picked}
+}
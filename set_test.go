@@ -0,0 +1,139 @@
+package compress
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSymmetricDifference(t *testing.T) {
+	t.Run("should keep elements outside the overlap", func(t *testing.T) {
+		result := SymmetricDifference(New([]int{1, 2, 2, 3, 4}), New([]int{4, 5, 3, 6, 5}))
+		assert.Equal(t, []int{1, 2, 5, 6}, result.Collect())
+	})
+	t.Run("should keep everything for disjoint inputs", func(t *testing.T) {
+		result := SymmetricDifference(New([]int{1, 2}), New([]int{3, 4}))
+		assert.Equal(t, []int{1, 2, 3, 4}, result.Collect())
+	})
+	t.Run("should be empty for identical inputs", func(t *testing.T) {
+		result := SymmetricDifference(New([]int{1, 2, 3}), New([]int{3, 2, 1}))
+		assert.Empty(t, result.Collect())
+	})
+}
+
+func TestJaccard(t *testing.T) {
+	t.Run("should return 1 for identical sets", func(t *testing.T) {
+		assert.Equal(t, 1.0, Jaccard(New([]int{1, 2, 2, 3}), New([]int{3, 2, 1})))
+	})
+	t.Run("should return 0 for disjoint sets", func(t *testing.T) {
+		assert.Equal(t, 0.0, Jaccard(New([]int{1, 2}), New([]int{3, 4})))
+	})
+	t.Run("should measure partial overlap", func(t *testing.T) {
+		assert.InDelta(t, 0.5, Jaccard(New([]string{"a", "b", "c"}), New([]string{"b", "c", "d"})), 1e-12)
+	})
+	t.Run("should return 1 for two empty sets", func(t *testing.T) {
+		assert.Equal(t, 1.0, Jaccard(New([]int{}), New([]int{})))
+	})
+}
+
+func TestDistinct(t *testing.T) {
+	t.Run("should dedupe integers in first-seen order", func(t *testing.T) {
+		assert.Equal(t, []int{3, 1, 2}, Distinct(New([]int{3, 1, 3, 2, 1})).Collect())
+	})
+	t.Run("should dedupe strings", func(t *testing.T) {
+		assert.Equal(t, []string{"go", "rust"}, Distinct(New([]string{"go", "rust", "go"})).Collect())
+	})
+	t.Run("should dedupe structs by id", func(t *testing.T) {
+		type user struct {
+			ID   int
+			Tags []string
+		}
+		users := []user{{1, []string{"a"}}, {2, nil}, {1, []string{"b"}}}
+		result := DistinctBy(New(users), func(u user) int { return u.ID }).Collect()
+		assert.Equal(t, []user{{1, []string{"a"}}, {2, nil}}, result)
+	})
+}
+
+func TestContains(t *testing.T) {
+	c := New([]string{"go", "rust", "zig", "go"})
+	t.Run("should find present elements", func(t *testing.T) {
+		assert.True(t, Contains(c, "zig"))
+		assert.Equal(t, 2, IndexOf(c, "zig"))
+	})
+	t.Run("should return the first index", func(t *testing.T) {
+		assert.Equal(t, 0, IndexOf(c, "go"))
+	})
+	t.Run("should report absent elements", func(t *testing.T) {
+		assert.False(t, Contains(c, "java"))
+		assert.Equal(t, -1, IndexOf(c, "java"))
+	})
+	t.Run("should report nothing for an empty slice", func(t *testing.T) {
+		assert.False(t, Contains(New([]int{}), 0))
+		assert.Equal(t, -1, IndexOf(New([]int{}), 0))
+	})
+}
+
+func TestGreedyCover(t *testing.T) {
+	type team struct {
+		Name   string
+		Skills []string
+	}
+	covers := func(tm team) map[string]struct{} { return toSet(tm.Skills) }
+	teams := []team{
+		{"a", []string{"go", "sql"}},
+		{"b", []string{"go", "js", "css", "html"}},
+		{"c", []string{"sql", "k8s"}},
+		{"d", []string{"k8s"}},
+	}
+	t.Run("should pick the largest gain first", func(t *testing.T) {
+		universe := toSet([]string{"go", "js", "css", "html", "sql", "k8s"})
+		result := GreedyCover(New(teams), covers, universe).Collect()
+		names := make([]string, len(result))
+		for i, tm := range result {
+			names[i] = tm.Name
+		}
+		assert.Equal(t, []string{"b", "c"}, names)
+	})
+	t.Run("should stop when no progress is possible", func(t *testing.T) {
+		universe := toSet([]string{"go", "rust"})
+		result := GreedyCover(New(teams), covers, universe).Collect()
+		assert.Len(t, result, 1)
+		assert.Equal(t, "a", result[0].Name)
+	})
+}
+
+func TestSetOperations(t *testing.T) {
+	a := []int{1, 2, 3, 4}
+	b := []int{3, 4, 5, 6}
+	t.Run("should union overlapping slices", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, Union(New(a), New(b)).Collect())
+	})
+	t.Run("should intersect overlapping slices", func(t *testing.T) {
+		assert.Equal(t, []int{3, 4}, Intersect(New(a), New(b)).Collect())
+	})
+	t.Run("should subtract overlapping slices", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, Difference(New(a), New(b)).Collect())
+	})
+	t.Run("should dedupe inputs with duplicates", func(t *testing.T) {
+		x := []int{2, 1, 2, 3, 1}
+		y := []int{3, 3, 4, 2}
+		assert.Equal(t, []int{2, 1, 3, 4}, Union(New(x), New(y)).Collect())
+		assert.Equal(t, []int{2, 3}, Intersect(New(x), New(y)).Collect())
+		assert.Equal(t, []int{1}, Difference(New(x), New(y)).Collect())
+	})
+	t.Run("should leave the inputs untouched", func(t *testing.T) {
+		x := New([]int{1, 1, 2})
+		Intersect(x, New([]int{1}))
+		Difference(x, New([]int{1}))
+		assert.Equal(t, []int{1, 1, 2}, x.Collect())
+	})
+}
+
+func TestDistinctFunc(t *testing.T) {
+	t.Run("should dedupe floats within an epsilon", func(t *testing.T) {
+		closeEnough := func(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+		result := DistinctFunc(New([]float64{0.1 + 0.2, 0.3, 1.0, 0.3001, 1.0000000001}), closeEnough).Collect()
+		assert.Equal(t, []float64{0.1 + 0.2, 1.0, 0.3001}, result)
+	})
+}
@@ -0,0 +1,14 @@
+package compress
+
+// Stage is a reusable pipeline fragment that transforms a Compress.
+type Stage[T any] func(*Compress[T]) *Compress[T]
+
+// Compose returns a single Stage that applies the given stages in order.
+func Compose[T any](stages ...Stage[T]) Stage[T] {
+	return func(c *Compress[T]) *Compress[T] {
+		for _, stage := range stages {
+			c = stage(c)
+		}
+		return c
+	}
+}
@@ -0,0 +1,23 @@
+package compress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompose(t *testing.T) {
+	evens := Stage[int](func(c *Compress[int]) *Compress[int] {
+		return c.Filter(func(x int) bool { return x%2 == 0 })
+	})
+	double := Stage[int](func(c *Compress[int]) *Compress[int] {
+		return c.Map(func(x int) int { return x * 2 })
+	})
+	t.Run("should apply the stages in order", func(t *testing.T) {
+		pipeline := Compose(evens, double)
+		assert.Equal(t, []int{4, 8}, pipeline(New([]int{1, 2, 3, 4})).Collect())
+	})
+	t.Run("should leave the data untouched without stages", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, Compose[int]()(New([]int{1, 2})).Collect())
+	})
+}
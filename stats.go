@@ -0,0 +1,190 @@
+package compress
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// WeightedSample draws n elements with replacement, each with probability proportional to its weight.
+// Elements with a zero or negative weight are never selected. If no element has a positive weight,
+// an empty Compress is returned.
+func WeightedSample[T any](c *Compress[T], weight func(T) float64, n int, r *rand.Rand) *Compress[T] {
+	cumulative := make([]float64, len(c.data))
+	total := 0.0
+	for i, elem := range c.data {
+		if w := weight(elem); w > 0 {
+			total += w
+		}
+		cumulative[i] = total
+	}
+	if total == 0 || n <= 0 {
+		return New[T](nil)
+	}
+	result := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		target := r.Float64() * total
+		index := sort.Search(len(cumulative), func(j int) bool {
+			return cumulative[j] > target
+		})
+		result = append(result, c.data[index])
+	}
+	return New(result)
+}
+
+// Welford accumulates a running mean and variance in a single, numerically stable pass.
+// The zero value is ready to use.
+type Welford struct {
+	count int
+	mean  float64
+	m2    float64
+}
+
+// Push adds x to the accumulator.
+func (w *Welford) Push(x float64) {
+	w.count++
+	delta := x - w.mean
+	w.mean += delta / float64(w.count)
+	w.m2 += delta * (x - w.mean)
+}
+
+// Count returns how many values have been pushed.
+func (w *Welford) Count() int {
+	return w.count
+}
+
+// Mean returns the mean of the pushed values, or 0 if none were pushed.
+func (w *Welford) Mean() float64 {
+	return w.mean
+}
+
+// Variance returns the population variance of the pushed values, or 0 if none were pushed.
+func (w *Welford) Variance() float64 {
+	if w.count == 0 {
+		return 0
+	}
+	return w.m2 / float64(w.count)
+}
+
+// RunningStats feeds every element into a Welford accumulator and returns it.
+// Go methods cannot be specialized to Compress[float64], so this is a package-level function.
+func RunningStats(c *Compress[float64]) Welford {
+	var w Welford
+	for _, x := range c.data {
+		w.Push(x)
+	}
+	return w
+}
+
+// MovingMedian returns the median of every sliding window of the given size.
+// The window is kept as a sorted slice, updated by binary search as it slides.
+// NaN values are kept out of the sorted slice; a window containing a NaN has a NaN median.
+// If window is not positive or larger than the slice, the result is empty.
+func MovingMedian(c *Compress[float64], window int) []float64 {
+	if window <= 0 || window > len(c.data) {
+		return []float64{}
+	}
+	sorted := make([]float64, 0, window)
+	nans := 0
+	medians := make([]float64, 0, len(c.data)-window+1)
+	for i, x := range c.data {
+		if i >= window {
+			if out := c.data[i-window]; math.IsNaN(out) {
+				nans--
+			} else {
+				old := sort.SearchFloat64s(sorted, out)
+				sorted = append(sorted[:old], sorted[old+1:]...)
+			}
+		}
+		if math.IsNaN(x) {
+			nans++
+		} else {
+			slot := sort.SearchFloat64s(sorted, x)
+			sorted = append(sorted, 0)
+			copy(sorted[slot+1:], sorted[slot:])
+			sorted[slot] = x
+		}
+		if i >= window-1 {
+			if nans > 0 {
+				medians = append(medians, math.NaN())
+				continue
+			}
+			mid := window / 2
+			if window%2 == 1 {
+				medians = append(medians, sorted[mid])
+			} else {
+				medians = append(medians, (sorted[mid-1]+sorted[mid])/2)
+			}
+		}
+	}
+	return medians
+}
+
+// StringSummary returns the number of strings, their total length in bytes and
+// the longest and shortest strings, in a single pass. On ties the first string wins.
+// An empty slice yields zeros and empty strings.
+func StringSummary(c *Compress[string]) (count int, totalLen int, longest, shortest string) {
+	for i, s := range c.data {
+		if i == 0 || len(s) > len(longest) {
+			longest = s
+		}
+		if i == 0 || len(s) < len(shortest) {
+			shortest = s
+		}
+		totalLen += len(s)
+	}
+	return len(c.data), totalLen, longest, shortest
+}
+
+// ShannonEntropy returns the Shannon entropy, in bits, of the frequency distribution of the elements.
+// An empty slice or a single repeated value has zero entropy.
+func ShannonEntropy[T comparable](c *Compress[T]) float64 {
+	counts := make(map[T]int)
+	for _, elem := range c.data {
+		counts[elem]++
+	}
+	total := float64(len(c.data))
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / total
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// SmoothMovingAverage returns a new Compress of the same length where each element is the average
+// of the window elements centered on it. Near the edges the window is truncated to the elements
+// that exist, so the ends are averaged over fewer values. A window of 1 or less returns a copy.
+// Each window is summed on its own, so a large value, NaN or Inf only affects the windows containing it.
+func SmoothMovingAverage(c *Compress[float64], window int) *Compress[float64] {
+	window = max(window, 1)
+	result := make([]float64, len(c.data))
+	for i := range c.data {
+		start := max(i-window/2, 0)
+		end := min(i-window/2+window, len(c.data))
+		sum := 0.0
+		for _, x := range c.data[start:end] {
+			sum += x
+		}
+		result[i] = sum / float64(end-start)
+	}
+	return &Compress[float64]{data: result}
+}
+
+// RollingStdDev returns the population standard deviation of every sliding window of the given size.
+// Each window is accumulated with Welford's algorithm to avoid subtracting large sums.
+// If window is not positive or larger than the slice, the result is empty.
+func RollingStdDev(c *Compress[float64], window int) []float64 {
+	if window <= 0 || window > len(c.data) {
+		return []float64{}
+	}
+	result := make([]float64, 0, len(c.data)-window+1)
+	for i := 0; i+window <= len(c.data); i++ {
+		var w Welford
+		for _, x := range c.data[i : i+window] {
+			w.Push(x)
+		}
+		result = append(result, math.Sqrt(w.Variance()))
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package compress
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeightedSample(t *testing.T) {
+	weights := map[string]float64{"a": 1, "b": 0, "c": 3, "d": 0}
+	weight := func(s string) float64 { return weights[s] }
+	t.Run("should draw n elements and never pick zero weights", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		sample := WeightedSample(New([]string{"a", "b", "c", "d"}), weight, 1000, r).Collect()
+		assert.Len(t, sample, 1000)
+		assert.NotContains(t, sample, "b")
+		assert.NotContains(t, sample, "d")
+		assert.Contains(t, sample, "a")
+		assert.Contains(t, sample, "c")
+	})
+	t.Run("should return empty when every weight is zero", func(t *testing.T) {
+		r := rand.New(rand.NewSource(42))
+		sample := WeightedSample(New([]string{"b", "d"}), weight, 10, r).Collect()
+		assert.Empty(t, sample)
+	})
+}
+
+func TestRunningStats(t *testing.T) {
+	t.Run("should match a naive two-pass computation", func(t *testing.T) {
+		data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+		mean := 0.0
+		for _, x := range data {
+			mean += x
+		}
+		mean /= float64(len(data))
+		variance := 0.0
+		for _, x := range data {
+			variance += (x - mean) * (x - mean)
+		}
+		variance /= float64(len(data))
+
+		w := RunningStats(New(data))
+		assert.Equal(t, len(data), w.Count())
+		assert.InDelta(t, mean, w.Mean(), 1e-12)
+		assert.InDelta(t, variance, w.Variance(), 1e-12)
+		assert.InDelta(t, 4.0, w.Variance(), 1e-12)
+	})
+	t.Run("should stay stable with a large offset", func(t *testing.T) {
+		w := RunningStats(New([]float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}))
+		assert.InDelta(t, 22.5, w.Variance(), 1e-6)
+	})
+	t.Run("should return zeros for an empty slice", func(t *testing.T) {
+		w := RunningStats(New([]float64{}))
+		assert.Equal(t, 0.0, w.Mean())
+		assert.Equal(t, 0.0, w.Variance())
+	})
+}
+
+func TestMovingMedian(t *testing.T) {
+	t.Run("should compute per-window medians", func(t *testing.T) {
+		result := MovingMedian(New([]float64{1, 9, 2, 8, 3, 3, 100}), 3)
+		assert.Equal(t, []float64{2, 8, 3, 3, 3}, result)
+	})
+	t.Run("should average the middle pair for even windows", func(t *testing.T) {
+		result := MovingMedian(New([]float64{1, 3, 5, 7}), 2)
+		assert.Equal(t, []float64{2, 4, 6}, result)
+	})
+	t.Run("should return NaN for windows containing NaN", func(t *testing.T) {
+		result := MovingMedian(New([]float64{1, math.NaN(), 2, 3}), 2)
+		assert.Len(t, result, 3)
+		assert.True(t, math.IsNaN(result[0]))
+		assert.True(t, math.IsNaN(result[1]))
+		assert.Equal(t, 2.5, result[2])
+	})
+	t.Run("should return empty when the window is too large", func(t *testing.T) {
+		assert.Empty(t, MovingMedian(New([]float64{1, 2}), 3))
+	})
+}
+
+func TestStringSummary(t *testing.T) {
+	t.Run("should summarize mixed lengths", func(t *testing.T) {
+		count, totalLen, longest, shortest := StringSummary(New([]string{"go", "gopher", "a", "rust", "b"}))
+		assert.Equal(t, 5, count)
+		assert.Equal(t, 14, totalLen)
+		assert.Equal(t, "gopher", longest)
+		assert.Equal(t, "a", shortest)
+	})
+	t.Run("should return zeros for an empty slice", func(t *testing.T) {
+		count, totalLen, longest, shortest := StringSummary(New([]string{}))
+		assert.Equal(t, 0, count)
+		assert.Equal(t, 0, totalLen)
+		assert.Empty(t, longest)
+		assert.Empty(t, shortest)
+	})
+}
+
+func TestShannonEntropy(t *testing.T) {
+	t.Run("should be maximal for a uniform distribution", func(t *testing.T) {
+		assert.InDelta(t, 2.0, ShannonEntropy(New([]string{"a", "b", "c", "d"})), 1e-12)
+	})
+	t.Run("should be zero for a single value", func(t *testing.T) {
+		assert.Equal(t, 0.0, ShannonEntropy(New([]int{7, 7, 7})))
+	})
+	t.Run("should compute a known mixed distribution", func(t *testing.T) {
+		assert.InDelta(t, 1.5, ShannonEntropy(New([]int{1, 1, 2, 3})), 1e-12)
+	})
+	t.Run("should be zero for an empty slice", func(t *testing.T) {
+		assert.Equal(t, 0.0, ShannonEntropy(New([]int{})))
+	})
+}
+
+func TestSmoothMovingAverage(t *testing.T) {
+	t.Run("should smooth a step function", func(t *testing.T) {
+		result := SmoothMovingAverage(New([]float64{0, 0, 0, 3, 3, 3}), 3).Collect()
+		assert.Len(t, result, 6)
+		expected := []float64{0, 0, 1, 2, 3, 3}
+		for i := range expected {
+			assert.InDelta(t, expected[i], result[i], 1e-12)
+		}
+	})
+	t.Run("should truncate the window at the edges", func(t *testing.T) {
+		result := SmoothMovingAverage(New([]float64{2, 4, 6}), 5).Collect()
+		assert.Equal(t, []float64{4, 4, 4}, result)
+	})
+	t.Run("should not let a large leading value swamp later windows", func(t *testing.T) {
+		result := SmoothMovingAverage(New([]float64{1e17, 0, 0, 0, 0, 1, 1, 1}), 3).Collect()
+		expected := []float64{5e16, 1e17 / 3, 0, 0, 1.0 / 3, 2.0 / 3, 1, 1}
+		for i := range expected {
+			assert.InDelta(t, expected[i], result[i], 1e-12)
+		}
+	})
+	t.Run("should only propagate NaN to windows containing it", func(t *testing.T) {
+		result := SmoothMovingAverage(New([]float64{3, 3, 3, math.NaN(), 3, 3, 3}), 3).Collect()
+		for i, x := range result {
+			if i >= 2 && i <= 4 {
+				assert.True(t, math.IsNaN(x))
+			} else {
+				assert.Equal(t, 3.0, x)
+			}
+		}
+	})
+	t.Run("should copy the data for a window of one", func(t *testing.T) {
+		assert.Equal(t, []float64{1, 5, 2}, SmoothMovingAverage(New([]float64{1, 5, 2}), 1).Collect())
+	})
+}
+
+func TestRollingStdDev(t *testing.T) {
+	t.Run("should compute per-window standard deviations", func(t *testing.T) {
+		result := RollingStdDev(New([]float64{2, 4, 4, 4, 5, 5, 7, 9}), 4)
+		assert.Len(t, result, 5)
+		expected := []float64{0.8660254, 0.4330127, 0.5, 1.0897247, 1.6583124}
+		for i := range expected {
+			assert.InDelta(t, expected[i], result[i], 1e-6)
+		}
+	})
+	t.Run("should stay stable with a large offset", func(t *testing.T) {
+		result := RollingStdDev(New([]float64{1e9 + 1, 1e9 + 3}), 2)
+		assert.InDelta(t, 1.0, result[0], 1e-6)
+	})
+	t.Run("should return empty when the window is too large", func(t *testing.T) {
+		assert.Empty(t, RollingStdDev(New([]float64{1}), 2))
+	})
+}
@@ -1,6 +1,12 @@
 package stream
 
-import "sync"
+import (
+	"bufio"
+	"io"
+	"sync"
+
+	"github.com/ronanzindev/compress"
+)
 
 type Stream[T any] struct {
 	data <-chan T
@@ -59,11 +65,17 @@ func (s *Stream[T]) Reduce(inital T, reduce func(T, T) T) T {
 	result := inital
 
 	for item := range s.data {
-		result = reduce(inital, item)
+		result = reduce(result, item)
 	}
 	return result
 }
 
+// ReduceOrDefault folds the stream into a single value, threading the accumulator through reducer.
+// For an empty stream it returns initial unchanged.
+func (s *Stream[T]) ReduceOrDefault(initial T, reducer func(acc, cur T) T) T {
+	return s.Reduce(initial, reducer)
+}
+
 func (s *Stream[T]) Limit(n int) *Stream[T] {
 	ch := make(chan T)
 	go func() {
@@ -99,6 +111,12 @@ func (s *Stream[T]) Parallel(workers int) *Stream[T] {
 	return &Stream[T]{data: ch}
 }
 
+// discard consumes and drops every remaining element, releasing the upstream goroutines.
+func (s *Stream[T]) discard() {
+	for range s.data {
+	}
+}
+
 func (s *Stream[T]) Collect() []T {
 	result := make([]T, 0)
 	for item := range s.data {
@@ -106,3 +124,284 @@ func (s *Stream[T]) Collect() []T {
 	}
 	return result
 }
+
+// SkipWhile discards the leading elements for which the predicate returns true
+// and forwards every element from the first failure onwards.
+func (s *Stream[T]) SkipWhile(predicate func(T) bool) *Stream[T] {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		skipping := true
+		for item := range s.data {
+			if skipping && predicate(item) {
+				continue
+			}
+			skipping = false
+			ch <- item
+		}
+	}()
+	return &Stream[T]{data: ch}
+}
+
+// TakeUntil forwards elements until the predicate first returns true, then closes.
+// The matching element is exclusive: it is not forwarded. Elements after the cutoff are
+// discarded so upstream stages can finish.
+func (s *Stream[T]) TakeUntil(predicate func(T) bool) *Stream[T] {
+	ch := make(chan T)
+	go func() {
+		for item := range s.data {
+			if predicate(item) {
+				break
+			}
+			ch <- item
+		}
+		close(ch)
+		s.discard()
+	}()
+	return &Stream[T]{data: ch}
+}
+
+// StreamGroupBy drains the stream into groups keyed by keyFn,
+// preserving the arrival order of elements within each group.
+func StreamGroupBy[T any, K comparable](s *Stream[T], keyFn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for item := range s.data {
+		key := keyFn(item)
+		groups[key] = append(groups[key], item)
+	}
+	return groups
+}
+
+// StreamCollectDistinct drains the stream and returns its distinct elements in first-seen order.
+func StreamCollectDistinct[T comparable](s *Stream[T]) []T {
+	result := make([]T, 0)
+	seen := make(map[T]struct{})
+	for item := range s.data {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		result = append(result, item)
+	}
+	return result
+}
+
+// StreamWriteLines writes each element to w as a line, formatted by format, as it arrives.
+// Output is buffered internally and flushed before returning; the first write error is returned
+// and the remaining elements are discarded in the background so upstream stages can finish.
+func StreamWriteLines[T any](s *Stream[T], w io.Writer, format func(T) string) error {
+	writer := bufio.NewWriter(w)
+	for item := range s.data {
+		if _, err := writer.WriteString(format(item) + "\n"); err != nil {
+			go s.discard()
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+// CollectProgress drains the stream like Collect, calling onProgress with the running count after every element.
+func (s *Stream[T]) CollectProgress(onProgress func(count int)) []T {
+	result := make([]T, 0)
+	for item := range s.data {
+		result = append(result, item)
+		onProgress(len(result))
+	}
+	return result
+}
+
+// reorderWindow is how many in-flight inputs StreamMapToParallelOrdered allows per worker.
+const reorderWindow = 4
+
+type sequenced[T any] struct {
+	seq  int
+	item T
+}
+
+// StreamMapToParallelOrdered maps the stream with fn across workers goroutines
+// while emitting results in the original input order.
+// Each input is tagged with a sequence number and out-of-order results wait in a reorder buffer.
+// At most workers*reorderWindow inputs are in flight at once, which bounds that buffer
+// even when an early element is much slower than the ones after it.
+func StreamMapToParallelOrdered[T, R any](s *Stream[T], workers int, fn func(T) R) *Stream[R] {
+	if workers < 1 {
+		workers = 1
+	}
+	inFlight := make(chan struct{}, workers*reorderWindow)
+	tagged := make(chan sequenced[T])
+	go func() {
+		defer close(tagged)
+		seq := 0
+		for item := range s.data {
+			inFlight <- struct{}{}
+			tagged <- sequenced[T]{seq: seq, item: item}
+			seq++
+		}
+	}()
+
+	results := make(chan sequenced[R])
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for in := range tagged {
+				results <- sequenced[R]{seq: in.seq, item: fn(in.item)}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	ch := make(chan R)
+	go func() {
+		defer close(ch)
+		pending := make(map[int]R)
+		next := 0
+		for out := range results {
+			pending[out.seq] = out.item
+			for {
+				item, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				ch <- item
+				<-inFlight
+				next++
+			}
+		}
+	}()
+	return &Stream[R]{data: ch}
+}
+
+// Buffer inserts a channel of capacity size between stages so the producer can run
+// up to size elements ahead of a slower consumer.
+func (s *Stream[T]) Buffer(size int) *Stream[T] {
+	if size < 0 {
+		size = 0
+	}
+	ch := make(chan T, size)
+	go func() {
+		defer close(ch)
+		for item := range s.data {
+			ch <- item
+		}
+	}()
+	return &Stream[T]{data: ch}
+}
+
+// StreamSum drains a numeric stream into its total without buffering the elements.
+func StreamSum[T compress.Number](s *Stream[T]) T {
+	var total T
+	for item := range s.data {
+		total += item
+	}
+	return total
+}
+
+// CollectLast drains the stream keeping only the last n elements in a ring buffer.
+// If n is not positive, the stream is drained and an empty slice is returned.
+func (s *Stream[T]) CollectLast(n int) []T {
+	if n <= 0 {
+		for range s.data {
+		}
+		return make([]T, 0)
+	}
+	ring := make([]T, n)
+	count := 0
+	for item := range s.data {
+		ring[count%n] = item
+		count++
+	}
+	if count <= n {
+		return ring[:count]
+	}
+	start := count % n
+	return append(ring[start:], ring[:start]...)
+}
+
+// Result carries either a value or the error produced while computing it.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// MapResult maps the stream with a fallible fn, forwarding each outcome as a Result.
+func MapResult[T, R any](s *Stream[T], fn func(T) (R, error)) *Stream[Result[R]] {
+	ch := make(chan Result[R])
+	go func() {
+		defer close(ch)
+		for item := range s.data {
+			value, err := fn(item)
+			ch <- Result[R]{Value: value, Err: err}
+		}
+	}()
+	return &Stream[Result[R]]{data: ch}
+}
+
+// CollectWithErrors drains a stream of Results, separating successful values from errors.
+// Both slices keep the arrival order.
+func CollectWithErrors[T any](s *Stream[Result[T]]) ([]T, []error) {
+	values := make([]T, 0)
+	errs := make([]error, 0)
+	for result := range s.data {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+			continue
+		}
+		values = append(values, result.Value)
+	}
+	return values, errs
+}
+
+// CollectSummary drains the stream returning the element count and an order-dependent checksum
+// without buffering the elements. The checksum equals compress.Hash over the same elements.
+func (s *Stream[T]) CollectSummary(hashElem func(T) uint64) (count int, checksum uint64) {
+	checksum = compress.HashSeed
+	for item := range s.data {
+		checksum = compress.HashCombine(checksum, hashElem(item))
+		count++
+	}
+	return count, checksum
+}
+
+// StreamMapFilter maps each element with fn and forwards the result only when fn reports true,
+// fusing Map and Filter into a single stage.
+func StreamMapFilter[T, R any](s *Stream[T], fn func(T) (R, bool)) *Stream[R] {
+	ch := make(chan R)
+	go func() {
+		defer close(ch)
+		for item := range s.data {
+			if result, ok := fn(item); ok {
+				ch <- result
+			}
+		}
+	}()
+	return &Stream[R]{data: ch}
+}
+
+// StreamLastByKey drains the stream keeping only the last element seen for each key,
+// compacting a stream of updates into its latest state.
+func StreamLastByKey[T any, K comparable](s *Stream[T], keyFn func(T) K) map[K]T {
+	latest := make(map[K]T)
+	for item := range s.data {
+		latest[keyFn(item)] = item
+	}
+	return latest
+}
+
+// Drain consumes the stream, passing each element to sink, and stops at the first sink error,
+// which it returns. The remaining elements are discarded in the background so upstream stages
+// can finish. It returns nil once the stream is exhausted without errors.
+func (s *Stream[T]) Drain(sink func(T) error) error {
+	for item := range s.data {
+		if err := sink(item); err != nil {
+			go s.discard()
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,312 @@
+package stream
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ronanzindev/compress"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertGoroutinesReleased waits until the goroutine count drops back to baseline.
+func assertGoroutinesReleased(t *testing.T, baseline int) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		if runtime.NumGoroutine() <= baseline {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("goroutines not released: %d running, expected at most %d", runtime.NumGoroutine(), baseline)
+}
+
+func TestTakeUntil(t *testing.T) {
+	t.Run("should stop before the sentinel", func(t *testing.T) {
+		result := NewStream([]int{1, 2, 3, -1, 4, 5}).TakeUntil(func(item int) bool {
+			return item == -1
+		}).Collect()
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+	t.Run("should forward everything when the sentinel is missing", func(t *testing.T) {
+		result := NewStream([]int{1, 2, 3}).TakeUntil(func(item int) bool {
+			return item == -1
+		}).Collect()
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+	t.Run("should release upstream after the sentinel", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+		for i := 0; i < 100; i++ {
+			result := NewStream([]int{1, -1, 2, 3, 4}).Map(func(x int) int { return x }).TakeUntil(func(item int) bool {
+				return item == -1
+			}).Collect()
+			assert.Equal(t, []int{1}, result)
+		}
+		assertGoroutinesReleased(t, baseline)
+	})
+}
+
+func TestSkipWhile(t *testing.T) {
+	t.Run("should skip only the leading run", func(t *testing.T) {
+		result := NewStream([]int{1, 2, 5, 1, 6}).SkipWhile(func(item int) bool {
+			return item < 3
+		}).Collect()
+		assert.Equal(t, []int{5, 1, 6}, result)
+	})
+}
+
+func TestStreamGroupBy(t *testing.T) {
+	type product struct {
+		Name     string
+		Category string
+	}
+	t.Run("should group elements by category", func(t *testing.T) {
+		products := []product{
+			{"apple", "fruit"},
+			{"carrot", "vegetable"},
+			{"banana", "fruit"},
+		}
+		groups := StreamGroupBy(NewStream(products), func(p product) string {
+			return p.Category
+		})
+		assert.Len(t, groups, 2)
+		assert.Equal(t, []product{{"apple", "fruit"}, {"banana", "fruit"}}, groups["fruit"])
+		assert.Equal(t, []product{{"carrot", "vegetable"}}, groups["vegetable"])
+	})
+}
+
+func TestStreamCollectDistinct(t *testing.T) {
+	t.Run("should keep the first occurrence of each element", func(t *testing.T) {
+		result := StreamCollectDistinct(NewStream([]int{3, 1, 3, 2, 1, 4}))
+		assert.Equal(t, []int{3, 1, 2, 4}, result)
+	})
+}
+
+func TestStreamWriteLines(t *testing.T) {
+	t.Run("should write one line per element", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := StreamWriteLines(NewStream([]int{1, 2, 3}), &buf, strconv.Itoa)
+		assert.NoError(t, err)
+		assert.Equal(t, "1\n2\n3\n", buf.String())
+	})
+	t.Run("should return the write error", func(t *testing.T) {
+		err := StreamWriteLines(NewStream([]int{1, 2, 3}), failingWriter{}, strconv.Itoa)
+		assert.ErrorIs(t, err, errWrite)
+	})
+	t.Run("should release upstream goroutines on a mid-stream error", func(t *testing.T) {
+		input := make([]int, 10000)
+		baseline := runtime.NumGoroutine()
+		err := StreamWriteLines(NewStream(input).Map(func(x int) int { return x }), failingWriter{}, strconv.Itoa)
+		assert.ErrorIs(t, err, errWrite)
+		assertGoroutinesReleased(t, baseline)
+	})
+}
+
+var errWrite = errors.New("disk full")
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errWrite
+}
+
+func TestCollectProgress(t *testing.T) {
+	t.Run("should report increasing counts ending at the total", func(t *testing.T) {
+		counts := make([]int, 0)
+		result := NewStream([]int{5, 6, 7, 8}).CollectProgress(func(count int) {
+			counts = append(counts, count)
+		})
+		assert.Equal(t, []int{5, 6, 7, 8}, result)
+		assert.Equal(t, []int{1, 2, 3, 4}, counts)
+	})
+}
+
+func TestStreamMapToParallelOrdered(t *testing.T) {
+	t.Run("should keep input order with varying durations", func(t *testing.T) {
+		input := []int{5, 1, 4, 2, 3, 0, 6, 2}
+		result := StreamMapToParallelOrdered(NewStream(input), 4, func(x int) string {
+			time.Sleep(time.Duration(x) * time.Millisecond)
+			return strconv.Itoa(x)
+		}).Collect()
+		assert.Equal(t, []string{"5", "1", "4", "2", "3", "0", "6", "2"}, result)
+	})
+	t.Run("should bound pending results behind a slow head", func(t *testing.T) {
+		const workers = 4
+		input := make([]int, 20000)
+		for i := range input {
+			input[i] = i
+		}
+		release := make(chan struct{})
+		var started atomic.Int64
+		s := StreamMapToParallelOrdered(NewStream(input), workers, func(x int) int {
+			started.Add(1)
+			if x == 0 {
+				<-release
+			}
+			return x
+		})
+		time.Sleep(50 * time.Millisecond)
+		assert.LessOrEqual(t, started.Load(), int64(workers*reorderWindow))
+		close(release)
+		assert.Equal(t, input, s.Collect())
+	})
+}
+
+func TestBuffer(t *testing.T) {
+	t.Run("should forward every element in order", func(t *testing.T) {
+		input := make([]int, 100)
+		for i := range input {
+			input[i] = i
+		}
+		assert.Equal(t, input, NewStream(input).Buffer(8).Collect())
+	})
+}
+
+func benchmarkBuffer(b *testing.B, size int) {
+	input := make([]int, 64)
+	for i := 0; i < b.N; i++ {
+		NewStream(input).Map(func(x int) int {
+			time.Sleep(10 * time.Microsecond)
+			return x
+		}).Buffer(size).Map(func(x int) int {
+			time.Sleep(10 * time.Microsecond)
+			return x
+		}).Collect()
+	}
+}
+
+func BenchmarkBufferUnbuffered(b *testing.B) { benchmarkBuffer(b, 0) }
+
+func BenchmarkBuffer64(b *testing.B) { benchmarkBuffer(b, 64) }
+
+func TestStreamSum(t *testing.T) {
+	t.Run("should sum a filtered stream", func(t *testing.T) {
+		s := NewStream([]int{1, 2, 3, 4, 5, 6}).Filter(func(x int) bool { return x%2 == 0 })
+		assert.Equal(t, 12, StreamSum(s))
+	})
+	t.Run("should return zero for an empty stream", func(t *testing.T) {
+		assert.Equal(t, 0.0, StreamSum(NewStream([]float64{})))
+	})
+}
+
+func TestCollectLast(t *testing.T) {
+	t.Run("should keep the last n elements", func(t *testing.T) {
+		result := NewStream([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}).CollectLast(3)
+		assert.Equal(t, []int{8, 9, 10}, result)
+	})
+	t.Run("should keep everything when the stream is shorter", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2}, NewStream([]int{1, 2}).CollectLast(3))
+	})
+}
+
+func TestReduceOrDefault(t *testing.T) {
+	sum := func(acc, cur int) int { return acc + cur }
+	t.Run("should sum a non-empty stream", func(t *testing.T) {
+		assert.Equal(t, 10, NewStream([]int{1, 2, 3, 4}).ReduceOrDefault(0, sum))
+	})
+	t.Run("should return initial for an empty stream", func(t *testing.T) {
+		assert.Equal(t, 42, NewStream([]int{}).ReduceOrDefault(42, sum))
+	})
+}
+
+func TestCollectWithErrors(t *testing.T) {
+	t.Run("should separate values from errors", func(t *testing.T) {
+		s := MapResult(NewStream([]string{"1", "x", "3", "y"}), strconv.Atoi)
+		values, errs := CollectWithErrors(s)
+		assert.Equal(t, []int{1, 3}, values)
+		assert.Len(t, errs, 2)
+		for _, err := range errs {
+			assert.ErrorIs(t, err, strconv.ErrSyntax)
+		}
+	})
+}
+
+func TestReduce(t *testing.T) {
+	t.Run("should thread the accumulator", func(t *testing.T) {
+		result := NewStream([]int{1, 2, 3, 4}).Reduce(0, func(acc, cur int) int {
+			return acc + cur
+		})
+		assert.Equal(t, 10, result)
+	})
+	t.Run("should keep the order with a non-commutative reducer", func(t *testing.T) {
+		result := NewStream([]string{"a", "b", "c"}).Reduce(">", func(acc, cur string) string {
+			return acc + cur
+		})
+		assert.Equal(t, ">abc", result)
+	})
+}
+
+func TestCollectSummary(t *testing.T) {
+	t.Run("should match the eager Hash", func(t *testing.T) {
+		data := []int{4, 8, 15, 16, 23, 42}
+		hashElem := func(x int) uint64 { return uint64(x) }
+		count, checksum := NewStream(data).CollectSummary(hashElem)
+		assert.Equal(t, len(data), count)
+		assert.Equal(t, compress.Hash(compress.New(data), hashElem), checksum)
+	})
+}
+
+func TestStreamMapFilter(t *testing.T) {
+	t.Run("should keep only parseable numbers", func(t *testing.T) {
+		result := StreamMapFilter(NewStream([]string{"1", "two", "3", "", "5"}), func(s string) (int, bool) {
+			n, err := strconv.Atoi(s)
+			return n, err == nil
+		}).Collect()
+		assert.Equal(t, []int{1, 3, 5}, result)
+	})
+}
+
+func TestStreamLastByKey(t *testing.T) {
+	type update struct {
+		Key   string
+		Value int
+	}
+	t.Run("should keep the latest update per key", func(t *testing.T) {
+		updates := []update{{"a", 1}, {"b", 1}, {"a", 2}, {"c", 1}, {"b", 3}, {"a", 4}}
+		result := StreamLastByKey(NewStream(updates), func(u update) string { return u.Key })
+		assert.Equal(t, map[string]update{
+			"a": {"a", 4},
+			"b": {"b", 3},
+			"c": {"c", 1},
+		}, result)
+	})
+}
+
+func TestDrain(t *testing.T) {
+	errFull := errors.New("sink full")
+	t.Run("should stop at the first sink error", func(t *testing.T) {
+		written := make([]int, 0)
+		err := NewStream([]int{1, 2, 3, 4, 5}).Drain(func(x int) error {
+			if x == 3 {
+				return errFull
+			}
+			written = append(written, x)
+			return nil
+		})
+		assert.ErrorIs(t, err, errFull)
+		assert.Equal(t, []int{1, 2}, written)
+	})
+	t.Run("should consume everything without errors", func(t *testing.T) {
+		count := 0
+		err := NewStream([]int{1, 2, 3}).Drain(func(int) error {
+			count++
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+	t.Run("should release upstream goroutines on error", func(t *testing.T) {
+		baseline := runtime.NumGoroutine()
+		for i := 0; i < 100; i++ {
+			err := NewStream([]int{1, 2, 3, 4, 5}).Map(func(x int) int { return x }).Drain(func(int) error {
+				return errFull
+			})
+			assert.ErrorIs(t, err, errFull)
+		}
+		assertGoroutinesReleased(t, baseline)
+	})
+}
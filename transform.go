@@ -0,0 +1,99 @@
+package compress
+
+// MapTo applies f to every element and returns a new Compress of the results, which may have a different type.
+// It is a package-level function because Go methods cannot introduce new type parameters.
+func MapTo[T, R any](c *Compress[T], f func(T) R) *Compress[R] {
+	result := make([]R, len(c.data))
+	for i, elem := range c.data {
+		result[i] = f(elem)
+	}
+	return &Compress[R]{data: result}
+}
+
+// ScanFold returns the running accumulator after each element, threading an accumulator
+// whose type may differ from the elements. The initial value itself is not emitted.
+func ScanFold[T, A any](c *Compress[T], initial A, reducer func(A, T) A) *Compress[A] {
+	result := make([]A, 0, len(c.data))
+	acc := initial
+	for _, elem := range c.data {
+		acc = reducer(acc, elem)
+		result = append(result, acc)
+	}
+	return &Compress[A]{data: result}
+}
+
+// Transpose turns the rows into columns. Ragged rows are truncated to the shortest row,
+// so the result has as many columns as the shortest row has elements.
+func Transpose[T any](rows *Compress[[]T]) [][]T {
+	if len(rows.data) == 0 {
+		return [][]T{}
+	}
+	width := len(rows.data[0])
+	for _, row := range rows.data[1:] {
+		width = min(width, len(row))
+	}
+	columns := make([][]T, width)
+	for j := range columns {
+		columns[j] = make([]T, len(rows.data))
+		for i, row := range rows.data {
+			columns[j][i] = row[j]
+		}
+	}
+	return columns
+}
+
+// Pairwise applies combine to every pair of adjacent elements, returning a Compress one element
+// shorter than the input whose element type may differ from T.
+func Pairwise[T, R any](c *Compress[T], combine func(prev, cur T) R) *Compress[R] {
+	result := make([]R, 0, max(len(c.data)-1, 0))
+	for i := 1; i < len(c.data); i++ {
+		result = append(result, combine(c.data[i-1], c.data[i]))
+	}
+	return &Compress[R]{data: result}
+}
+
+// MapFilter transforms each element with fn in a single pass, keeping the result only when fn reports true.
+func MapFilter[T, R any](c *Compress[T], fn func(T) (R, bool)) *Compress[R] {
+	result := make([]R, 0, len(c.data))
+	for _, elem := range c.data {
+		if mapped, ok := fn(elem); ok {
+			result = append(result, mapped)
+		}
+	}
+	return &Compress[R]{data: result}
+}
+
+// Fold reduces the elements into an accumulator whose type may differ from the elements.
+func Fold[T, A any](c *Compress[T], initial A, f func(A, T) A) A {
+	acc := initial
+	for _, elem := range c.data {
+		acc = f(acc, elem)
+	}
+	return acc
+}
+
+// NGrams returns every contiguous run of n elements as a Compress of slices.
+// Each n-gram is a copy, so it does not alias the receiver's data.
+// If n is not positive or larger than the slice, the result is empty.
+func NGrams[T any](c *Compress[T], n int) *Compress[[]T] {
+	if n <= 0 || n > len(c.data) {
+		return New[[]T](nil)
+	}
+	grams := make([][]T, 0, len(c.data)-n+1)
+	for i := 0; i+n <= len(c.data); i++ {
+		gram := make([]T, n)
+		copy(gram, c.data[i:i+n])
+		grams = append(grams, gram)
+	}
+	return &Compress[[]T]{data: grams}
+}
+
+// Flatten concatenates the inner slices, in order, into a single Compress.
+// Nil and empty inner slices contribute nothing.
+func Flatten[T any](c *Compress[[]T]) *Compress[T] {
+	result := make([]T, 0)
+	for _, inner := range c.data {
+		result = append(result, inner...)
+	}
+	return &Compress[T]{data: result}
+}
@@ -0,0 +1,136 @@
+package compress
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanFold(t *testing.T) {
+	concat := func(acc string, x int) string { return acc + strconv.Itoa(x) }
+	t.Run("should emit running concatenations", func(t *testing.T) {
+		result := ScanFold(New([]int{1, 2, 3}), ">", concat).Collect()
+		assert.Equal(t, []string{">1", ">12", ">123"}, result)
+	})
+	t.Run("should return empty for an empty slice", func(t *testing.T) {
+		assert.Empty(t, ScanFold(New([]int{}), "", concat).Collect())
+	})
+}
+
+func TestTranspose(t *testing.T) {
+	t.Run("should transpose a 2x3 matrix", func(t *testing.T) {
+		result := Transpose(New([][]int{{1, 2, 3}, {4, 5, 6}}))
+		assert.Equal(t, [][]int{{1, 4}, {2, 5}, {3, 6}}, result)
+	})
+	t.Run("should stop at the shortest ragged row", func(t *testing.T) {
+		result := Transpose(New([][]int{{1, 2, 3}, {4}, {7, 8}}))
+		assert.Equal(t, [][]int{{1, 4, 7}}, result)
+	})
+	t.Run("should return empty for no rows", func(t *testing.T) {
+		assert.Empty(t, Transpose(New([][]int{})))
+	})
+}
+
+func TestPairwise(t *testing.T) {
+	transition := func(prev, cur int) string { return fmt.Sprintf("%d->%d", prev, cur) }
+	t.Run("should format adjacent transitions", func(t *testing.T) {
+		result := Pairwise(New([]int{1, 4, 2, 8}), transition).Collect()
+		assert.Equal(t, []string{"1->4", "4->2", "2->8"}, result)
+	})
+	t.Run("should return empty for fewer than two elements", func(t *testing.T) {
+		assert.Empty(t, Pairwise(New([]int{1}), transition).Collect())
+		assert.Empty(t, Pairwise(New([]int{}), transition).Collect())
+	})
+}
+
+func TestMapFilter(t *testing.T) {
+	t.Run("should keep only valid integers", func(t *testing.T) {
+		result := MapFilter(New([]string{"10", "x", "-3", "4.5", "7"}), func(s string) (int, bool) {
+			n, err := strconv.Atoi(s)
+			return n, err == nil
+		}).Collect()
+		assert.Equal(t, []int{10, -3, 7}, result)
+	})
+}
+
+func TestMapTo(t *testing.T) {
+	t.Run("should convert ints to strings", func(t *testing.T) {
+		result := MapTo(New([]int{1, 22, 333}), strconv.Itoa).Collect()
+		assert.Equal(t, []string{"1", "22", "333"}, result)
+	})
+	t.Run("should extract struct fields", func(t *testing.T) {
+		type user struct {
+			ID   int
+			Name string
+		}
+		result := MapTo(New([]user{{1, "ana"}, {2, "bob"}}), func(u user) string { return u.Name }).Collect()
+		assert.Equal(t, []string{"ana", "bob"}, result)
+	})
+	t.Run("should return empty for an empty slice", func(t *testing.T) {
+		assert.Empty(t, MapTo(New([]int{}), strconv.Itoa).Collect())
+	})
+}
+
+func TestFold(t *testing.T) {
+	t.Run("should fold strings into a rune count", func(t *testing.T) {
+		total := Fold(New([]string{"go", "café", "日本"}), 0, func(acc int, s string) int {
+			return acc + utf8.RuneCountInString(s)
+		})
+		assert.Equal(t, 8, total)
+	})
+	t.Run("should fold structs into a summary", func(t *testing.T) {
+		type order struct {
+			Amount float64
+			Paid   bool
+		}
+		type summary struct {
+			Total  float64
+			Unpaid int
+		}
+		orders := []order{{10, true}, {5.5, false}, {4.5, false}}
+		result := Fold(New(orders), summary{}, func(acc summary, o order) summary {
+			acc.Total += o.Amount
+			if !o.Paid {
+				acc.Unpaid++
+			}
+			return acc
+		})
+		assert.Equal(t, summary{Total: 20, Unpaid: 2}, result)
+	})
+}
+
+func TestNGrams(t *testing.T) {
+	tokens := []string{"the", "quick", "brown", "fox"}
+	t.Run("should build bigrams", func(t *testing.T) {
+		result := NGrams(New(tokens), 2).Collect()
+		assert.Equal(t, [][]string{{"the", "quick"}, {"quick", "brown"}, {"brown", "fox"}}, result)
+	})
+	t.Run("should build trigrams", func(t *testing.T) {
+		result := NGrams(New(tokens), 3).Collect()
+		assert.Equal(t, [][]string{{"the", "quick", "brown"}, {"quick", "brown", "fox"}}, result)
+	})
+	t.Run("should return empty when n exceeds the length", func(t *testing.T) {
+		assert.Empty(t, NGrams(New(tokens), 5).Collect())
+	})
+	t.Run("should not alias the input", func(t *testing.T) {
+		data := []string{"a", "b", "c"}
+		grams := NGrams(New(data), 2).Collect()
+		grams[0][1] = "z"
+		assert.Equal(t, []string{"a", "b", "c"}, data)
+	})
+}
+
+func TestFlatten(t *testing.T) {
+	t.Run("should concatenate inner slices skipping nil and empty ones", func(t *testing.T) {
+		result := Flatten(New([][]int{{1, 2}, nil, {}, {3}, {4, 5}})).Collect()
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, result)
+	})
+	t.Run("should return empty for empty input", func(t *testing.T) {
+		result := Flatten(New([][]int{}))
+		assert.NotNil(t, result)
+		assert.Empty(t, result.Collect())
+	})
+}